@@ -0,0 +1,60 @@
+// Package aoc holds what is shared by the solutions of every day: the results
+// they report and how those are measured.
+package aoc
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"time"
+)
+
+// Result holds the answer computed for one part of the puzzle, together with
+// the time and the heap allocations it took to compute it
+type Result struct {
+	Part    int      `json:"part"`
+	Answer  string   `json:"answer"`
+	Image   []string `json:"image,omitempty"`
+	Elapsed int64    `json:"elapsed_ns"`
+	Allocs  uint64   `json:"allocs"`
+	Bytes   uint64   `json:"bytes"`
+}
+
+// Solver reads the puzzle input of a day from the given file and solves it
+type Solver func(path string) ([]Result, error)
+
+// Drawer reads the puzzle input of a day from the given file and saves
+// pictures of the puzzle state into the given directory, every cell of the
+// puzzle being scale by scale pixels
+type Drawer func(path, dir string, scale int) error
+
+// Watcher reads the puzzle input of a day from the given file and solves it
+// while redrawing the puzzle state on the terminal behind w, at most fps
+// times a second
+type Watcher func(path string, w io.Writer, fps int) error
+
+// Measure runs the solver for the given part and records its answer, elapsed
+// time and memory statistics; solvers that render an image return its rows
+func Measure(part int, solve func() interface{}) Result {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	answer := solve()
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	result := Result{
+		Part:    part,
+		Elapsed: elapsed.Nanoseconds(),
+		Allocs:  after.Mallocs - before.Mallocs,
+		Bytes:   after.TotalAlloc - before.TotalAlloc,
+	}
+	if image, ok := answer.([]string); ok {
+		result.Image = image
+	} else {
+		result.Answer = fmt.Sprint(answer)
+	}
+
+	return result
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Benchmark is the outcome of one of the benchmarks of a day
+type Benchmark struct {
+	Day         int     `json:"day"`
+	Name        string  `json:"name"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  float64 `json:"bytes_per_op"`
+	AllocsPerOp float64 `json:"allocs_per_op"`
+	Error       string  `json:"error,omitempty"`
+}
+
+type benchmarkKey struct {
+	day  int
+	name string
+}
+
+// run the benchmarks of the given day and parse their results
+func runBenchmarks(root string, day int, benchtime string, timeout time.Duration) []Benchmark {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "go", "test", "-run", "^$", "-bench", ".", "-benchmem",
+		"-benchtime", benchtime, "-timeout", timeout.String(), ".")
+	cmd.Dir = filepath.Join(root, fmt.Sprintf("day%d", day))
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return []Benchmark{{Day: day, Error: msg}}
+	}
+
+	var benchmarks []Benchmark
+	scanner := bufio.NewScanner(&stdout)
+	for scanner.Scan() {
+		if b, ok := parseBenchmarkLine(scanner.Text()); ok {
+			b.Day = day
+			benchmarks = append(benchmarks, b)
+		}
+	}
+
+	return benchmarks
+}
+
+// parse a result line printed by go test, e.g.
+// BenchmarkPart1-8   	     100	  12345 ns/op	  1234 B/op	  12 allocs/op
+func parseBenchmarkLine(line string) (Benchmark, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return Benchmark{}, false
+	}
+
+	// drop the GOMAXPROCS suffix so results from different machines match
+	name := fields[0]
+	if i := strings.LastIndex(name, "-"); i != -1 {
+		name = name[:i]
+	}
+
+	b := Benchmark{Name: name}
+	for i := 2; i+1 < len(fields); i += 2 {
+		val, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return Benchmark{}, false
+		}
+		switch fields[i+1] {
+		case "ns/op":
+			b.NsPerOp = val
+		case "B/op":
+			b.BytesPerOp = val
+		case "allocs/op":
+			b.AllocsPerOp = val
+		}
+	}
+
+	return b, true
+}
+
+// load the baseline results, a missing file is the same as an empty one
+func loadBaseline(path string) (map[benchmarkKey]Benchmark, error) {
+	baseline := make(map[benchmarkKey]Benchmark)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return baseline, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var list []Benchmark
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	for _, b := range list {
+		baseline[benchmarkKey{b.Day, b.Name}] = b
+	}
+
+	return baseline, nil
+}
+
+// store the successful benchmarks into the baseline, keeping the results of
+// the days that were not run
+func saveBaseline(path string, baseline map[benchmarkKey]Benchmark, benchmarks []Benchmark) error {
+	for _, b := range benchmarks {
+		if b.Error == "" {
+			baseline[benchmarkKey{b.Day, b.Name}] = b
+		}
+	}
+
+	list := make([]Benchmark, 0, len(baseline))
+	for _, b := range baseline {
+		list = append(list, b)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Day != list[j].Day {
+			return list[i].Day < list[j].Day
+		}
+		return list[i].Name < list[j].Name
+	})
+
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// print the benchmarks next to their baseline, flagging the ones that got
+// slower by more than the threshold percentage; it returns false if any did
+func compareBenchmarks(benchmarks []Benchmark, baseline map[benchmarkKey]Benchmark, threshold float64) bool {
+	slower := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DAY\tBENCHMARK\tBASELINE\tCURRENT\tDELTA\tALLOCS\tSTATUS")
+	for _, b := range benchmarks {
+		if b.Error != "" {
+			fmt.Fprintf(w, "%d\t\t\t\t\t\tFAIL: %s\n", b.Day, b.Error)
+			slower++
+			continue
+		}
+
+		base, ok := baseline[benchmarkKey{b.Day, b.Name}]
+		if !ok || base.NsPerOp == 0 {
+			fmt.Fprintf(w, "%d\t%s\t\t%s\t\t%.0f\tnew\n", b.Day, b.Name, time.Duration(b.NsPerOp), b.AllocsPerOp)
+			continue
+		}
+
+		delta := (b.NsPerOp - base.NsPerOp) / base.NsPerOp * 100
+		status := "ok"
+		if delta > threshold {
+			status = "SLOWER"
+			slower++
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%+.1f%%\t%.0f\t%s\n", b.Day, b.Name,
+			time.Duration(base.NsPerOp), time.Duration(b.NsPerOp), delta, b.AllocsPerOp, status)
+	}
+	w.Flush()
+
+	return slower == 0
+}
@@ -0,0 +1,386 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/day1"
+	"github.com/stanciua/adventofcode2019/day10"
+	"github.com/stanciua/adventofcode2019/day11"
+	"github.com/stanciua/adventofcode2019/day12"
+	"github.com/stanciua/adventofcode2019/day13"
+	"github.com/stanciua/adventofcode2019/day14"
+	"github.com/stanciua/adventofcode2019/day15"
+	"github.com/stanciua/adventofcode2019/day16"
+	"github.com/stanciua/adventofcode2019/day17"
+	"github.com/stanciua/adventofcode2019/day18"
+	"github.com/stanciua/adventofcode2019/day19"
+	"github.com/stanciua/adventofcode2019/day2"
+	"github.com/stanciua/adventofcode2019/day20"
+	"github.com/stanciua/adventofcode2019/day21"
+	"github.com/stanciua/adventofcode2019/day22"
+	"github.com/stanciua/adventofcode2019/day23"
+	"github.com/stanciua/adventofcode2019/day24"
+	"github.com/stanciua/adventofcode2019/day25"
+	"github.com/stanciua/adventofcode2019/day3"
+	"github.com/stanciua/adventofcode2019/day4"
+	"github.com/stanciua/adventofcode2019/day5"
+	"github.com/stanciua/adventofcode2019/day6"
+	"github.com/stanciua/adventofcode2019/day7"
+	"github.com/stanciua/adventofcode2019/day8"
+	"github.com/stanciua/adventofcode2019/day9"
+	"github.com/stanciua/adventofcode2019/ocr"
+)
+
+// solvers maps every day to its solution
+var solvers = map[int]aoc.Solver{
+	1: day1.Solve, 2: day2.Solve, 3: day3.Solve, 4: day4.Solve, 5: day5.Solve,
+	6: day6.Solve, 7: day7.Solve, 8: day8.Solve, 9: day9.Solve, 10: day10.Solve,
+	11: day11.Solve, 12: day12.Solve, 13: day13.Solve, 14: day14.Solve, 15: day15.Solve,
+	16: day16.Solve, 17: day17.Solve, 18: day18.Solve, 19: day19.Solve, 20: day20.Solve,
+	21: day21.Solve, 22: day22.Solve, 23: day23.Solve, 24: day24.Solve, 25: day25.Solve,
+}
+
+// drawers maps the days whose puzzle state can be pictured to their drawing
+var drawers = map[int]aoc.Drawer{
+	3: day3.Draw, 8: day8.Draw, 10: day10.Draw, 11: day11.Draw, 13: day13.Draw,
+	15: day15.Draw, 17: day17.Draw, 19: day19.Draw, 20: day20.Draw, 24: day24.Draw,
+}
+
+// watchers maps the days whose simulation can be watched on the terminal to
+// their visualizer
+var watchers = map[int]aoc.Watcher{
+	13: day13.Watch, 15: day15.Watch, 17: day17.Watch, 24: day24.Watch,
+}
+
+// Record is the result of one puzzle part as reported by a day solution,
+// tagged with the day and input file it belongs to and the error, if the
+// solution failed
+type Record struct {
+	Day     int      `json:"day"`
+	Part    int      `json:"part"`
+	Input   string   `json:"input"`
+	Answer  string   `json:"answer"`
+	Image   []string `json:"image,omitempty"`
+	Elapsed int64    `json:"elapsed_ns"`
+	Allocs  uint64   `json:"allocs"`
+	Bytes   uint64   `json:"bytes"`
+	Error   string   `json:"error,omitempty"`
+}
+
+func main() {
+	day := flag.Int("day", 0, "solve a single day and print its answers")
+	input := flag.String("input", "", "input file of the single day, defaults to dayN/input/part1.txt")
+	asJSON := flag.Bool("json", false, "print the answers of the single day as JSON")
+	root := flag.String("root", ".", "directory containing the day solutions")
+	days := flag.String("days", "1-25", "days to run, e.g. 1-5,8,11")
+	format := flag.String("format", "json", "output format: json or csv")
+	timeout := flag.Duration("timeout", 10*time.Minute, "maximum running time for a single day")
+	answersFile := flag.String("answers", "answers.json", "file storing the expected answers")
+	verifyMode := flag.Bool("verify", false, "check the answers against the expected answers file")
+	recordMode := flag.Bool("record", false, "store the answers into the expected answers file")
+	benchMode := flag.Bool("bench", false, "run the benchmarks and compare them against the baseline")
+	baselineFile := flag.String("baseline", "baseline.json", "file storing the baseline benchmark results")
+	saveMode := flag.Bool("save-baseline", false, "store the benchmark results as the new baseline")
+	threshold := flag.Float64("threshold", 10, "slowdown percentage over the baseline reported as a regression")
+	benchtime := flag.String("benchtime", "1s", "run time or iterations of each benchmark, as for go test")
+	drawDir := flag.String("draw", "", "save pictures of the single day into this directory instead of solving it")
+	scale := flag.Int("scale", 4, "size in pixels of a puzzle cell in the saved pictures")
+	watchMode := flag.Bool("watch", false, "watch the simulation of the single day on the terminal instead of solving it")
+	fps := flag.Int("fps", 30, "frames per second drawn when watching a simulation, 0 for no limit")
+	flag.Parse()
+
+	if *day != 0 && *watchMode {
+		if err := watchDay(*root, *day, *input, *fps); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *day != 0 && *drawDir != "" {
+		if err := drawDay(*root, *day, *input, *drawDir, *scale); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *day != 0 {
+		if err := solveDay(*root, *day, *input, *asJSON); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	selected, err := parseDays(*days)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *benchMode {
+		baseline, err := loadBaseline(*baselineFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var benchmarks []Benchmark
+		for _, day := range selected {
+			benchmarks = append(benchmarks, runBenchmarks(*root, day, *benchtime, *timeout)...)
+		}
+
+		ok := compareBenchmarks(benchmarks, baseline, *threshold)
+		if *saveMode {
+			if err := saveBaseline(*baselineFile, baseline, benchmarks); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		return
+	}
+
+	var records []Record
+	for _, day := range selected {
+		records = append(records, runDay(*root, day, *timeout)...)
+	}
+
+	if *verifyMode || *recordMode {
+		answers, err := loadAnswers(*answersFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if *recordMode {
+			recordAnswers(records, answers)
+			if err := saveAnswers(*answersFile, answers); err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		if *verifyMode && !printVerdicts(verify(records, answers)) {
+			os.Exit(1)
+		}
+		return
+	}
+
+	switch *format {
+	case "json":
+		err = writeJSON(records)
+	case "csv":
+		err = writeCSV(records)
+	default:
+		err = fmt.Errorf("unsupported output format: %s", *format)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// solve one day in this process and print its answers, either as text or as
+// JSON for the runner to collect
+func solveDay(root string, day int, input string, asJSON bool) error {
+	solve, ok := solvers[day]
+	if !ok {
+		return fmt.Errorf("invalid day %d", day)
+	}
+	if input == "" {
+		input = defaultInput(root, day)
+	}
+
+	results, err := solve(input)
+	if err != nil {
+		return err
+	}
+
+	if asJSON {
+		return json.NewEncoder(os.Stdout).Encode(results)
+	}
+
+	labels := []string{"1st", "2nd"}
+	for _, r := range results {
+		if r.Image != nil {
+			fmt.Printf("The result to %s part is:\n", labels[r.Part-1])
+			for _, line := range r.Image {
+				fmt.Println(line)
+			}
+		} else {
+			fmt.Printf("The result to %s part is:  %s\n", labels[r.Part-1], r.Answer)
+		}
+	}
+
+	return nil
+}
+
+// save the pictures of one day into dir, which is created when missing
+func drawDay(root string, day int, input, dir string, scale int) error {
+	draw, ok := drawers[day]
+	if !ok {
+		return fmt.Errorf("day %d has nothing to draw", day)
+	}
+	if input == "" {
+		input = defaultInput(root, day)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	return draw(input, dir, scale)
+}
+
+// watch the simulation of one day on the terminal
+func watchDay(root string, day int, input string, fps int) error {
+	watch, ok := watchers[day]
+	if !ok {
+		return fmt.Errorf("day %d has nothing to watch", day)
+	}
+	if input == "" {
+		input = defaultInput(root, day)
+	}
+
+	return watch(input, os.Stdout, fps)
+}
+
+func defaultInput(root string, day int) string {
+	return filepath.Join(root, fmt.Sprintf("day%d", day), "input", "part1.txt")
+}
+
+// parse a list of days and day ranges separated by commas, e.g. 1-5,8,11
+func parseDays(s string) ([]int, error) {
+	var days []int
+	for _, r := range strings.Split(s, ",") {
+		bounds := strings.SplitN(r, "-", 2)
+		first, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid day %q: %v", r, err)
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+				return nil, fmt.Errorf("invalid day %q: %v", r, err)
+			}
+		}
+		if first < 1 || last > 25 || first > last {
+			return nil, fmt.Errorf("invalid day range %q", r)
+		}
+
+		for day := first; day <= last; day++ {
+			days = append(days, day)
+		}
+	}
+
+	return days, nil
+}
+
+// run the solution of the given day against every file from its input
+// directory and collect the results it reports
+func runDay(root string, day int, timeout time.Duration) []Record {
+	dir := filepath.Join(root, fmt.Sprintf("day%d", day))
+	inputs, err := os.ReadDir(filepath.Join(dir, "input"))
+	if err != nil {
+		return []Record{{Day: day, Error: err.Error()}}
+	}
+
+	var records []Record
+	for _, input := range inputs {
+		if input.IsDir() {
+			continue
+		}
+		records = append(records, runDayWithInput(dir, day, input.Name(), timeout)...)
+	}
+
+	return records
+}
+
+// run the solution of the given day for one input file in a separate process,
+// so that it can be stopped once it times out; a solution that fails or times
+// out is reported as a single record with an error
+func runDayWithInput(dir string, day int, input string, timeout time.Duration) []Record {
+	self, err := os.Executable()
+	if err != nil {
+		return []Record{{Day: day, Input: input, Error: err.Error()}}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, self, "-day", strconv.Itoa(day), "-json",
+		"-input", filepath.Join(dir, "input", input))
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return []Record{{Day: day, Input: input, Error: msg}}
+	}
+
+	// the solution might print diagnostics before the results, which are
+	// always written on the last line
+	output := strings.TrimSpace(stdout.String())
+	output = output[strings.LastIndex(output, "\n")+1:]
+
+	var records []Record
+	if err := json.Unmarshal([]byte(output), &records); err != nil {
+		return []Record{{Day: day, Input: input, Error: fmt.Sprintf("invalid output: %v", err)}}
+	}
+	for i := range records {
+		records[i].Day = day
+		records[i].Input = input
+		// image answers are read back as text
+		if records[i].Image != nil {
+			if text, err := ocr.RecognizeRows(records[i].Image); err == nil {
+				records[i].Answer = text
+			} else {
+				records[i].Error = err.Error()
+			}
+		}
+	}
+
+	return records
+}
+
+func writeJSON(records []Record) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(records)
+}
+
+func writeCSV(records []Record) error {
+	w := csv.NewWriter(os.Stdout)
+	w.Write([]string{"day", "part", "input", "answer", "image", "elapsed_ns", "allocs", "bytes", "error"})
+	for _, r := range records {
+		w.Write([]string{
+			strconv.Itoa(r.Day),
+			strconv.Itoa(r.Part),
+			r.Input,
+			r.Answer,
+			strings.Join(r.Image, "\n"),
+			strconv.FormatInt(r.Elapsed, 10),
+			strconv.FormatUint(r.Allocs, 10),
+			strconv.FormatUint(r.Bytes, 10),
+			r.Error,
+		})
+	}
+	w.Flush()
+
+	return w.Error()
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"text/tabwriter"
+)
+
+// Answer is the expected answer to one part of a day's puzzle for an input file
+type Answer struct {
+	Day    int    `json:"day"`
+	Part   int    `json:"part"`
+	Input  string `json:"input"`
+	Answer string `json:"answer"`
+}
+
+type answerKey struct {
+	day   int
+	part  int
+	input string
+}
+
+// Status is the outcome of verifying an answer
+type Status string
+
+const (
+	// the answer matches the expected one
+	Pass Status = "PASS"
+	// the answer differs from the expected one
+	Regression Status = "REGRESSION"
+	// the solution failed to produce an answer
+	Fail Status = "FAIL"
+	// there's no expected answer to compare with
+	Missing Status = "MISSING"
+)
+
+// Verdict is the outcome of checking one answer against the expected one
+type Verdict struct {
+	Record
+	Expected string
+	Status   Status
+}
+
+// load the expected answers, a missing file is the same as an empty one
+func loadAnswers(path string) (map[answerKey]string, error) {
+	answers := make(map[answerKey]string)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return answers, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var list []Answer
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	for _, a := range list {
+		answers[answerKey{a.Day, a.Part, a.Input}] = a.Answer
+	}
+
+	return answers, nil
+}
+
+func saveAnswers(path string, answers map[answerKey]string) error {
+	list := make([]Answer, 0, len(answers))
+	for k, v := range answers {
+		list = append(list, Answer{Day: k.day, Part: k.part, Input: k.input, Answer: v})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Day != list[j].Day {
+			return list[i].Day < list[j].Day
+		}
+		if list[i].Input != list[j].Input {
+			return list[i].Input < list[j].Input
+		}
+		return list[i].Part < list[j].Part
+	})
+
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// store the answers of the successful records as the expected ones
+func recordAnswers(records []Record, answers map[answerKey]string) {
+	for _, r := range records {
+		if r.Error != "" {
+			fmt.Fprintf(os.Stderr, "day %d (%s): not recorded: %s\n", r.Day, r.Input, r.Error)
+			continue
+		}
+		answers[answerKey{r.Day, r.Part, r.Input}] = r.Answer
+	}
+}
+
+// check every record against its expected answer, a solution that failed
+// before reporting any part fails every expected answer for its input
+func verify(records []Record, answers map[answerKey]string) []Verdict {
+	var verdicts []Verdict
+	for _, r := range records {
+		if r.Part == 0 {
+			failed := false
+			for part := 1; part <= 2; part++ {
+				if expected, ok := answers[answerKey{r.Day, part, r.Input}]; ok {
+					record := r
+					record.Part = part
+					verdicts = append(verdicts, Verdict{record, expected, Fail})
+					failed = true
+				}
+			}
+			if !failed {
+				verdicts = append(verdicts, Verdict{r, "", Fail})
+			}
+			continue
+		}
+
+		expected, ok := answers[answerKey{r.Day, r.Part, r.Input}]
+		switch {
+		case r.Error != "":
+			verdicts = append(verdicts, Verdict{r, expected, Fail})
+		case !ok:
+			verdicts = append(verdicts, Verdict{r, expected, Missing})
+		case r.Answer != expected:
+			verdicts = append(verdicts, Verdict{r, expected, Regression})
+		default:
+			verdicts = append(verdicts, Verdict{r, expected, Pass})
+		}
+	}
+
+	return verdicts
+}
+
+// print the verdicts as a table followed by a summary, it returns false if
+// any answer failed or regressed
+func printVerdicts(verdicts []Verdict) bool {
+	counts := make(map[Status]int)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DAY\tPART\tINPUT\tSTATUS\tEXPECTED\tACTUAL\tERROR")
+	for _, v := range verdicts {
+		counts[v.Status]++
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\t%s\n", v.Day, v.Part, v.Input, v.Status, v.Expected, v.Answer, v.Error)
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d passed, %d regressions, %d failed, %d missing\n",
+		counts[Pass], counts[Regression], counts[Fail], counts[Missing])
+
+	return counts[Regression] == 0 && counts[Fail] == 0
+}
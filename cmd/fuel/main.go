@@ -0,0 +1,50 @@
+// Command fuel reports the fuel needed to launch the modules of a manifest,
+// per module, per group and in total, as JSON.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/stanciua/adventofcode2019/day1"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+func main() {
+	manifest := flag.String("manifest", "day1/input/part1.txt", "manifest of the modules, as JSON or one mass on every line, - for the standard input")
+	divisor := flag.Int("divisor", 3, "the mass is divided by this to get the fuel")
+	offset := flag.Int("offset", 2, "subtracted from the divided mass to get the fuel")
+	totals := flag.Bool("totals", false, "only report the groups and the totals, not every module")
+	flag.Parse()
+
+	if *divisor < 1 {
+		log.Fatalf("invalid divisor %d", *divisor)
+	}
+	if *offset < 0 {
+		log.Fatalf("invalid offset %d", *offset)
+	}
+
+	var m day1.Manifest
+	var err error
+	if *manifest == "-" {
+		m, err = day1.ParseManifest("stdin", os.Stdin)
+	} else {
+		m, err = parse.File(*manifest, day1.ParseManifest)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	report := day1.Compute(m, day1.Linear(*divisor, *offset))
+	if *totals {
+		report.Modules = nil
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,48 @@
+// Command orbits exports a map of orbits, read as the puzzle input or as
+// JSON, to Graphviz DOT with the path between two objects highlighted, or to
+// nested JSON.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/stanciua/adventofcode2019/day6"
+	"github.com/stanciua/adventofcode2019/orbit"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+func main() {
+	input := flag.String("input", "day6/input/part1.txt", "map of orbits, one CENTER)OBJECT pair on every line or JSON when ending in .json")
+	format := flag.String("format", "dot", "output format, dot or json")
+	from := flag.String("from", "YOU", "the path highlighted in the graph starts at this object")
+	to := flag.String("to", "SAN", "the path highlighted in the graph ends at this object")
+	flag.Parse()
+
+	var m *orbit.Map
+	var err error
+	if strings.HasSuffix(*input, ".json") {
+		m, err = parse.File(*input, orbit.ReadJSON)
+	} else {
+		m, err = day6.ReadInput(*input)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	switch *format {
+	case "dot":
+		// the path is left out when the objects are not on the map
+		path, _ := m.Path(*from, *to)
+		err = m.WriteDOT(os.Stdout, path)
+	case "json":
+		err = m.WriteJSON(os.Stdout)
+	default:
+		log.Fatalf("unknown format %q, expected dot or json", *format)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,44 @@
+// Command wires reports where the wires of a circuit cross, for every pair of
+// wires, as JSON.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/stanciua/adventofcode2019/day3"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+func main() {
+	input := flag.String("input", "day3/input/part1.txt", "paths of the wires, one on every line, - for the standard input")
+	k := flag.Int("k", 0, "also report the points and stretches where at least this many wires meet")
+	svg := flag.String("svg", "", "also draw the wires into this SVG file")
+	flag.Parse()
+
+	var paths [][]parse.Move
+	var err error
+	if *input == "-" {
+		paths, err = parse.Wires("stdin", os.Stdin)
+	} else {
+		paths, err = day3.ReadWires(*input)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	analyzer := day3.NewAnalyzer(paths)
+	if *svg != "" {
+		if err := analyzer.SaveSVG(*svg); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(analyzer.Report(*k)); err != nil {
+		log.Fatal(err)
+	}
+}
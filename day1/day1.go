@@ -0,0 +1,37 @@
+package day1
+
+import (
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	inputs, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(inputs) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(inputs) }),
+	}, nil
+}
+
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (Manifest, error) {
+	return parse.File(path, ParseManifest)
+}
+
+// Part1 returns the fuel needed for all the modules, not counting the fuel
+// itself
+func Part1(m Manifest) int {
+	return Compute(m, Naive).Total.Naive
+}
+
+// Part2 returns the fuel needed for all the modules and for the fuel itself
+func Part2(m Manifest) int {
+	return Compute(m, Naive).Total.Recursive
+}
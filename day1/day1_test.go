@@ -0,0 +1,106 @@
+package day1
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormulas(t *testing.T) {
+	recursive := Recursive(Naive)
+	for _, test := range []struct{ mass, naive, recursive int }{
+		{12, 2, 2},
+		{14, 2, 2},
+		{1969, 654, 966},
+		{100756, 33583, 50346},
+	} {
+		if fuel := Naive(test.mass); fuel != test.naive {
+			t.Errorf("mass %d: expected %d fuel, got %d", test.mass, test.naive, fuel)
+		}
+		if fuel := recursive(test.mass); fuel != test.recursive {
+			t.Errorf("mass %d: expected %d fuel recursively, got %d", test.mass, test.recursive, fuel)
+		}
+	}
+
+	if fuel := Linear(4, 1)(100); fuel != 24 {
+		t.Errorf("expected 24 fuel, got %d", fuel)
+	}
+
+	// formulas not shrinking the fuel stop after it
+	if fuel := Recursive(Linear(1, 0))(10); fuel != 10 {
+		t.Errorf("expected 10 fuel, got %d", fuel)
+	}
+	if fuel := Recursive(Linear(2, -5))(10); fuel != 10 {
+		t.Errorf("expected 10 fuel, got %d", fuel)
+	}
+}
+
+func TestCompute(t *testing.T) {
+	m, err := ParseManifest("manifest", strings.NewReader(`[
+		{"name": "engine", "mass": 1969, "group": "propulsion"},
+		{"name": "cabin", "mass": 14},
+		{"name": "thruster", "mass": 100756, "group": "propulsion"},
+		{"name": "antenna", "mass": 12, "group": "comms"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := Compute(m, Naive)
+	if len(report.Modules) != 4 || report.Modules[1].Fuel != (Fuel{2, 2}) {
+		t.Errorf("unexpected modules %v", report.Modules)
+	}
+	expected := []GroupFuel{
+		{Group: "comms", Modules: 1, Mass: 12, Fuel: Fuel{2, 2}},
+		{Group: "propulsion", Modules: 2, Mass: 102725, Fuel: Fuel{34237, 51312}},
+	}
+	if len(report.Groups) != 2 || report.Groups[0] != expected[0] || report.Groups[1] != expected[1] {
+		t.Errorf("expected groups %v, got %v", expected, report.Groups)
+	}
+	if report.Mass != 102751 || report.Total != (Fuel{34241, 51316}) {
+		t.Errorf("unexpected totals %d, %v", report.Mass, report.Total)
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	m, err := ParseManifest("masses", strings.NewReader("12\n14\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m) != 2 || m[1] != (Module{Name: "module 2", Mass: 14}) {
+		t.Errorf("unexpected manifest %v", m)
+	}
+
+	if _, err := ParseManifest("masses", strings.NewReader("12\nfourteen\n")); err == nil || err.Error() != `masses:2:1: invalid integer "fourteen": strconv.ParseInt: parsing "fourteen": invalid syntax` {
+		t.Errorf("unexpected error %v", err)
+	}
+	if _, err := ParseManifest("manifest", strings.NewReader(`[{"name": "engine", "mass": -1}]`)); err == nil {
+		t.Errorf("expected an error for a negative mass")
+	}
+	if _, err := ParseManifest("masses", strings.NewReader("12\n-14\n")); err == nil {
+		t.Errorf("expected an error for a negative mass")
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		inputs, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part1(inputs)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		inputs, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part2(inputs)
+	}
+}
@@ -0,0 +1,118 @@
+package day1
+
+import (
+	"sort"
+)
+
+// Formula returns the fuel needed to launch the given mass
+type Formula func(mass int) int
+
+// Linear returns the formula dividing the mass by divisor, rounding down,
+// and subtracting offset
+func Linear(divisor, offset int) Formula {
+	return func(mass int) int {
+		return mass/divisor - offset
+	}
+}
+
+// Naive is the formula of the puzzle, which does not account for the mass of
+// the fuel itself
+var Naive = Linear(3, 2)
+
+// Recursive returns the formula adding to the fuel of the mass the fuel of
+// that fuel, and so on until no more fuel is needed. A formula which does
+// not make the fuel smaller than its mass would never end, so the fuel stops
+// being added up there
+func Recursive(f Formula) Formula {
+	return func(mass int) int {
+		total := 0
+		for fuel := f(mass); fuel > 0; {
+			total += fuel
+			next := f(fuel)
+			if next >= fuel {
+				break
+			}
+			fuel = next
+		}
+		return total
+	}
+}
+
+// Module is a module of the spacecraft, Group is empty for the modules that
+// do not belong to any group
+type Module struct {
+	Name  string `json:"name"`
+	Mass  int    `json:"mass"`
+	Group string `json:"group,omitempty"`
+}
+
+// Manifest lists the modules of the spacecraft
+type Manifest []Module
+
+// Fuel is the fuel needed for a mass, without and with the mass of the fuel
+// itself
+type Fuel struct {
+	Naive     int `json:"naive"`
+	Recursive int `json:"recursive"`
+}
+
+func (f *Fuel) add(other Fuel) {
+	f.Naive += other.Naive
+	f.Recursive += other.Recursive
+}
+
+// ModuleFuel is the fuel needed for one module
+type ModuleFuel struct {
+	Module
+	Fuel Fuel `json:"fuel"`
+}
+
+// GroupFuel is the fuel needed for the modules of a group
+type GroupFuel struct {
+	Group   string `json:"group"`
+	Modules int    `json:"modules"`
+	Mass    int    `json:"mass"`
+	Fuel    Fuel   `json:"fuel"`
+}
+
+// Report is the breakdown of the fuel needed for a manifest, per module, per
+// group, sorted by name, and in total
+type Report struct {
+	Modules []ModuleFuel `json:"modules,omitempty"`
+	Groups  []GroupFuel  `json:"groups,omitempty"`
+	Mass    int          `json:"mass"`
+	Total   Fuel         `json:"total"`
+}
+
+// Compute returns the fuel needed for every module of the manifest with the
+// given formula, on its own and applied recursively
+func Compute(m Manifest, f Formula) Report {
+	recursive := Recursive(f)
+	report := Report{Modules: make([]ModuleFuel, 0, len(m))}
+	groups := make(map[string]*GroupFuel)
+	for _, module := range m {
+		fuel := Fuel{Naive: f(module.Mass), Recursive: recursive(module.Mass)}
+		report.Modules = append(report.Modules, ModuleFuel{Module: module, Fuel: fuel})
+		report.Mass += module.Mass
+		report.Total.add(fuel)
+
+		if module.Group == "" {
+			continue
+		}
+		group, ok := groups[module.Group]
+		if !ok {
+			group = &GroupFuel{Group: module.Group}
+			groups[module.Group] = group
+		}
+		group.Modules++
+		group.Mass += module.Mass
+		group.Fuel.add(fuel)
+	}
+
+	for _, group := range groups {
+		report.Groups = append(report.Groups, *group)
+	}
+	sort.Slice(report.Groups, func(i, j int) bool { return report.Groups[i].Group < report.Groups[j].Group })
+
+	return report
+}
@@ -0,0 +1,59 @@
+package day1
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// ParseManifest reads a manifest either as a JSON array of modules or, like
+// the puzzle input, as one module mass on every line; modules read from
+// masses are named after their line
+func ParseManifest(name string, r io.Reader) (Manifest, error) {
+	var m Manifest
+	br := bufio.NewReader(r)
+	if isJSON(br) {
+		if err := json.NewDecoder(br).Decode(&m); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	} else {
+		masses, err := parse.Integers(name, br)
+		if err != nil {
+			return nil, err
+		}
+		m = make(Manifest, len(masses))
+		for i, mass := range masses {
+			m[i] = Module{Name: fmt.Sprintf("module %d", i+1), Mass: int(mass)}
+		}
+	}
+
+	for i, module := range m {
+		if module.Mass < 0 {
+			return nil, fmt.Errorf("%s: module %d (%s) has a negative mass %d", name, i+1, module.Name, module.Mass)
+		}
+	}
+
+	return m, nil
+}
+
+// tell whether the first character of the stream, after white space, starts a
+// JSON array
+func isJSON(br *bufio.Reader) bool {
+	for i := 1; ; i++ {
+		peek, err := br.Peek(i)
+		if err != nil || len(peek) < i {
+			return false
+		}
+		switch peek[i-1] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case '[':
+			return true
+		default:
+			return false
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package day10
+
+import (
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// direction is the offset from the station to an asteroid divided by the
+// greatest common divisor of its coordinates, the same for every asteroid on
+// the same line of sight
+type direction struct {
+	dx, dy int
+}
+
+func gcd(a, b int) int {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// directionTo returns the direction of the asteroid seen from the station
+// and how many times farther than that direction it is
+func directionTo(station, asteroid grid.Point) (direction, int) {
+	dx, dy := asteroid.X-station.X, asteroid.Y-station.Y
+	n := gcd(dx, dy)
+
+	return direction{dx / n, dy / n}, n
+}
+
+// half returns 0 for the directions from straight up, included, to straight
+// down, left out, turning clockwise, and 1 for the others
+func (d direction) half() int {
+	if d.dx > 0 || d.dx == 0 && d.dy < 0 {
+		return 0
+	}
+
+	return 1
+}
+
+// before reports whether the laser, turning clockwise from straight up,
+// points in direction d before e; the y axis grows downwards so a positive
+// cross product turns clockwise
+func (d direction) before(e direction) bool {
+	if d.half() != e.half() {
+		return d.half() < e.half()
+	}
+
+	return d.dx*e.dy-d.dy*e.dx > 0
+}
+
+func getListOfAsteroids(space *grid.Dense[bool]) []grid.Point {
+	return space.Find(func(asteroid bool) bool { return asteroid })
+}
+
+// visible returns the number of asteroids detected from the station, one for
+// every line of sight
+func visible(station grid.Point, asteroids []grid.Point) int {
+	directions := make(map[direction]struct{}, len(asteroids))
+	for _, a := range asteroids {
+		if a != station {
+			d, _ := directionTo(station, a)
+			directions[d] = struct{}{}
+		}
+	}
+
+	return len(directions)
+}
+
+// Part1 returns the number of asteroids detected from the best place for the
+// monitoring station, together with that place
+func Part1(space *grid.Dense[bool]) (int, grid.Point) {
+	var maxPoint grid.Point
+	asteroids := getListOfAsteroids(space)
+	maxValue := -1
+	// check how many other asteroids every asteroid can detect
+	for _, p := range asteroids {
+		if n := visible(p, asteroids); n > maxValue {
+			maxValue = n
+			maxPoint = p
+		}
+	}
+
+	return maxValue, maxPoint
+}
+
+// Part2 returns X*100+Y of the 200th asteroid vaporized by the station
+func Part2(space *grid.Dense[bool], monitoringStation grid.Point) int {
+	p, ok := NewVaporization(space, monitoringStation).Nth(200)
+	if !ok {
+		return 0
+	}
+
+	return p.X*100 + p.Y
+}
+
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	space, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var point grid.Point
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} {
+			max, p := Part1(space)
+			point = p
+			return max
+		}),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(space, point) }),
+	}, nil
+}
+
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (*grid.Dense[bool], error) {
+	asteroids, err := parse.File(path, parse.Grid(".#"))
+	if err != nil {
+		return nil, err
+	}
+
+	space := grid.NewDense(asteroids.Width(), asteroids.Height(), false)
+	asteroids.Each(func(p grid.Point, c rune) {
+		space.Set(p, c == '#')
+	})
+
+	return space, nil
+}
@@ -0,0 +1,163 @@
+package day10
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+const example = `.#..##.###...#######
+##.############..##.
+.#.######.########.#
+.###.#######.####.#.
+#####.##.#.##.###.##
+..#####..#.#########
+####################
+#.####....###.#.#.##
+##.#################
+#####.##.###..####..
+..######..##.#######
+####.##.####...##..#
+.#####..#.######.###
+##...#.##########...
+#.##########.#######
+.####.#.###.###.#.##
+....##.##.###..#####
+.#.#.###########.###
+#.#.#.#####.####.###
+###.##.####.##.#..##`
+
+func readExample() *grid.Dense[bool] {
+	lines := strings.Split(example, "\n")
+	space := grid.NewDense(len(lines[0]), len(lines), false)
+	for y, line := range lines {
+		for x, c := range line {
+			space.Set(grid.Point{X: x, Y: y}, c == '#')
+		}
+	}
+
+	return space
+}
+
+func TestStation(t *testing.T) {
+	space := readExample()
+
+	count, station := Part1(space)
+	if count != 210 || station != (grid.Point{X: 11, Y: 13}) {
+		t.Errorf("Expected 210 asteroids seen from 11,13 got %d from %v", count, station)
+	}
+	if answer := Part2(space, station); answer != 802 {
+		t.Errorf("Expected 802 got %d", answer)
+	}
+}
+
+func TestVaporization(t *testing.T) {
+	space := readExample()
+	before := space.Render(func(asteroid bool) rune {
+		if asteroid {
+			return '#'
+		}
+		return '.'
+	})
+
+	v := NewVaporization(space, grid.Point{X: 11, Y: 13})
+	expected := map[int]grid.Point{
+		1: {X: 11, Y: 12}, 2: {X: 12, Y: 1}, 3: {X: 12, Y: 2}, 10: {X: 12, Y: 8}, 20: {X: 16, Y: 0},
+		50: {X: 16, Y: 9}, 100: {X: 10, Y: 16}, 199: {X: 9, Y: 6}, 200: {X: 8, Y: 2}, 201: {X: 10, Y: 9}, 299: {X: 11, Y: 1},
+	}
+	for n, p := range expected {
+		if got, ok := v.Nth(n); !ok || got != p {
+			t.Errorf("Expected %v as asteroid %d got %v", p, n, got)
+		}
+	}
+	all := v.All()
+	if len(all) != v.Len() || len(all) != 299 {
+		t.Errorf("Expected 299 asteroids vaporized got %d", len(all))
+	}
+	for i, p := range all {
+		if got, _ := v.Nth(i + 1); got != p {
+			t.Errorf("Expected %v as asteroid %d got %v", p, i+1, got)
+		}
+	}
+	if _, ok := v.Nth(300); ok {
+		t.Errorf("Expected no asteroid 300")
+	}
+
+	// from an empty place, the space staying as it was
+	laser := NewVaporization(space, grid.Point{X: 0, Y: 0}).Laser()
+	if p, _ := laser.Next(); p != (grid.Point{X: 1, Y: 0}) {
+		t.Errorf("Expected 1,0 first got %v", p)
+	}
+	after := space.Render(func(asteroid bool) rune {
+		if asteroid {
+			return '#'
+		}
+		return '.'
+	})
+	if strings.Join(before, "\n") != strings.Join(after, "\n") {
+		t.Errorf("Expected the space unchanged")
+	}
+}
+
+func TestHeatmap(t *testing.T) {
+	lines := []string{".#..#", ".....", "#####", "....#", "...##"}
+	space := grid.NewDense(5, 5, false)
+	for y, line := range lines {
+		for x, c := range line {
+			space.Set(grid.Point{X: x, Y: y}, c == '#')
+		}
+	}
+
+	var b strings.Builder
+	if err := WriteCSV(&b, Heatmap(space)); err != nil {
+		t.Fatal(err)
+	}
+	expected := ",7,,,7\n,,,,\n6,7,7,7,5\n,,,,7\n,,,8,7\n"
+	if b.String() != expected {
+		t.Errorf("Expected heatmap %q got %q", expected, b.String())
+	}
+}
+
+func TestDirectionOrder(t *testing.T) {
+	// clockwise from straight up, y growing downwards
+	directions := []direction{{0, -1}, {1, -2}, {1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}}
+	for i := range directions {
+		for j := range directions {
+			if before := directions[i].before(directions[j]); before != (i < j) {
+				t.Errorf("Expected %v before %v to be %v", directions[i], directions[j], i < j)
+			}
+		}
+	}
+
+	// too close for the slopes as floats to tell apart
+	a, b := direction{999_999_998, -999_999_999}, direction{999_999_999, -1_000_000_000}
+	if !a.before(b) || b.before(a) {
+		t.Errorf("Expected %v before %v", a, b)
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		grid, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part1(grid)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		grid, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		_, station := Part1(grid)
+		b.StartTimer()
+		Part2(grid, station)
+	}
+}
@@ -0,0 +1,57 @@
+package day10
+
+import (
+	"image/color"
+	"os"
+	"path/filepath"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+var palette = imaging.Palette{
+	Background: color.RGBA{0x08, 0x08, 0x18, 0xff},
+	Colors: map[rune]color.Color{
+		'#': color.RGBA{0xb0, 0xb0, 0xb0, 0xff},
+		'S': color.RGBA{0xff, 0x40, 0x40, 0xff},
+	},
+}
+
+// Draw saves the asteroid field into dir, with the best place for the
+// monitoring station marked in red, and the heatmap of how many asteroids
+// every asteroid detects as a picture and as CSV
+func Draw(path, dir string, scale int) error {
+	space, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	_, station := Part1(space)
+	field := space.Render(func(asteroid bool) rune {
+		if asteroid {
+			return '#'
+		}
+		return '.'
+	})
+	row := []rune(field[station.Y])
+	row[station.X] = 'S'
+	field[station.Y] = string(row)
+
+	if err := imaging.SavePNG(filepath.Join(dir, "day10-asteroids.png"), field, palette, scale); err != nil {
+		return err
+	}
+
+	heat := Heatmap(space)
+	if err := imaging.SavePNG(filepath.Join(dir, "day10-heatmap.png"), renderHeatmap(heat), heatPalette, scale); err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(dir, "day10-heatmap.csv"))
+	if err != nil {
+		return err
+	}
+	if err := WriteCSV(f, heat); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,103 @@
+package day10
+
+import (
+	"encoding/csv"
+	"image/color"
+	"io"
+	"strconv"
+
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+// Heatmap returns how many other asteroids every asteroid detects, -1 for
+// the places without an asteroid
+func Heatmap(space *grid.Dense[bool]) *grid.Dense[int] {
+	heat := grid.NewDense(space.Width(), space.Height(), -1)
+	asteroids := getListOfAsteroids(space)
+	for _, p := range asteroids {
+		heat.Set(p, visible(p, asteroids))
+	}
+
+	return heat
+}
+
+// WriteCSV writes the heatmap as a matrix of counts, one row of the space on
+// every line, the places without an asteroid left empty
+func WriteCSV(w io.Writer, heat *grid.Dense[int]) error {
+	out := csv.NewWriter(w)
+	record := make([]string, heat.Width())
+	for y := 0; y < heat.Height(); y++ {
+		for x, count := range heat.Row(y) {
+			record[x] = ""
+			if count >= 0 {
+				record[x] = strconv.Itoa(count)
+			}
+		}
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+
+	return out.Error()
+}
+
+// the heatmap is drawn with this many shades, from the asteroids detecting
+// the fewest others in blue to the ones detecting the most in red
+const shades = 64
+
+// the symbol of every shade, clear of the symbols of the other palettes
+func shade(level int) rune {
+	return rune(0x100 + level)
+}
+
+var heatPalette = func() imaging.Palette {
+	// the colors the shades go through
+	stops := []color.RGBA{
+		{0x20, 0x30, 0xa0, 0xff},
+		{0x20, 0xc0, 0xd0, 0xff},
+		{0xf0, 0xe0, 0x40, 0xff},
+		{0xf0, 0x30, 0x20, 0xff},
+	}
+	p := imaging.Palette{Background: color.RGBA{0x08, 0x08, 0x18, 0xff}, Colors: make(map[rune]color.Color, shades)}
+	for level := 0; level < shades; level++ {
+		at := float64(level) / (shades - 1) * float64(len(stops)-1)
+		i := int(at)
+		if i == len(stops)-1 {
+			i--
+		}
+		f := at - float64(i)
+		mix := func(a, b uint8) uint8 {
+			return uint8(float64(a) + (float64(b)-float64(a))*f)
+		}
+		a, b := stops[i], stops[i+1]
+		p.Colors[shade(level)] = color.RGBA{mix(a.R, b.R), mix(a.G, b.G), mix(a.B, b.B), 0xff}
+	}
+
+	return p
+}()
+
+// renderHeatmap draws every asteroid in the shade of the number of asteroids
+// it detects, scaled between the lowest and the highest count
+func renderHeatmap(heat *grid.Dense[int]) []string {
+	low, high := -1, -1
+	heat.Each(func(_ grid.Point, count int) {
+		if count >= 0 && (low < 0 || count < low) {
+			low = count
+		}
+		if count > high {
+			high = count
+		}
+	})
+
+	return heat.Render(func(count int) rune {
+		if count < 0 {
+			return ' '
+		}
+		if high == low {
+			return shade(shades - 1)
+		}
+		return shade((count - low) * (shades - 1) / (high - low))
+	})
+}
@@ -0,0 +1,146 @@
+package day10
+
+import (
+	"sort"
+
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+// Vaporization is the order in which the laser of a monitoring station
+// vaporizes the asteroids: on every turn the closest asteroid left on every
+// line of sight, the lines of sight taken clockwise from straight up
+type Vaporization struct {
+	Station grid.Point
+	// the asteroids of every line of sight from the closest, the lines in
+	// the order the laser goes through them
+	lines [][]grid.Point
+	// the lengths of the lines, shortest first
+	lengths []int
+	total   int
+}
+
+// NewVaporization returns the vaporization of the asteroids of the space by a
+// station at any place, an asteroid at the place of the station is left out;
+// the space is not changed
+func NewVaporization(space *grid.Dense[bool], station grid.Point) *Vaporization {
+	type target struct {
+		asteroid grid.Point
+		distance int
+	}
+	byDirection := make(map[direction][]target)
+	total := 0
+	for _, a := range getListOfAsteroids(space) {
+		if a != station {
+			d, n := directionTo(station, a)
+			byDirection[d] = append(byDirection[d], target{a, n})
+			total++
+		}
+	}
+
+	directions := make([]direction, 0, len(byDirection))
+	for d := range byDirection {
+		directions = append(directions, d)
+	}
+	sort.Slice(directions, func(i, j int) bool { return directions[i].before(directions[j]) })
+
+	v := &Vaporization{Station: station, total: total}
+	for _, d := range directions {
+		targets := byDirection[d]
+		sort.Slice(targets, func(i, j int) bool { return targets[i].distance < targets[j].distance })
+		line := make([]grid.Point, len(targets))
+		for i, t := range targets {
+			line[i] = t.asteroid
+		}
+		v.lines = append(v.lines, line)
+		v.lengths = append(v.lengths, len(line))
+	}
+	sort.Ints(v.lengths)
+
+	return v
+}
+
+// Len returns the number of asteroids vaporized
+func (v *Vaporization) Len() int {
+	return v.total
+}
+
+// Nth returns the nth asteroid vaporized, counting from 1, without going
+// through the ones before it; false if fewer asteroids are vaporized
+func (v *Vaporization) Nth(n int) (grid.Point, bool) {
+	if n < 1 || n > v.total {
+		return grid.Point{}, false
+	}
+
+	// skip the whole turns, every turn vaporizing one asteroid of each line
+	// longer than the turns before it
+	turn, short := 0, 0
+	for {
+		for short < len(v.lengths) && v.lengths[short] <= turn {
+			short++
+		}
+		if left := len(v.lengths) - short; n > left {
+			n -= left
+			turn++
+			continue
+		}
+		break
+	}
+
+	for _, line := range v.lines {
+		if len(line) > turn {
+			if n--; n == 0 {
+				return line[turn], true
+			}
+		}
+	}
+
+	// not reached, n is at most the lines left on the turn
+	return grid.Point{}, false
+}
+
+// All returns every asteroid vaporized, in order
+func (v *Vaporization) All() []grid.Point {
+	order := make([]grid.Point, 0, v.total)
+	laser := v.Laser()
+	for p, ok := laser.Next(); ok; p, ok = laser.Next() {
+		order = append(order, p)
+	}
+
+	return order
+}
+
+// Laser returns an iterator over the asteroids vaporized, in order
+func (v *Vaporization) Laser() *Laser {
+	return &Laser{lines: append([][]grid.Point(nil), v.lines...)}
+}
+
+// Laser goes through the asteroids of a vaporization one at a time
+type Laser struct {
+	// the lines of sight with asteroids left, the ones before next already
+	// shot on this turn
+	lines [][]grid.Point
+	next  int
+}
+
+// Next returns the next asteroid vaporized, false once all of them are
+func (l *Laser) Next() (grid.Point, bool) {
+	if l.next == len(l.lines) {
+		// the lines emptied on this turn are dropped for the next one
+		left := l.lines[:0]
+		for _, line := range l.lines {
+			if len(line) > 0 {
+				left = append(left, line)
+			}
+		}
+		l.lines, l.next = left, 0
+	}
+	if len(l.lines) == 0 {
+		return grid.Point{}, false
+	}
+
+	line := l.lines[l.next]
+	l.lines[l.next] = line[1:]
+	l.next++
+
+	return line[0], true
+}
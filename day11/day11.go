@@ -1,19 +1,13 @@
-package main
+package day11
 
 import (
-	"bufio"
 	"fmt"
-	"log"
-	"math"
-	"os"
-	"strconv"
-	"strings"
-)
 
-type Position struct {
-	x int
-	y int
-}
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/ocr"
+	"github.com/stanciua/adventofcode2019/parse"
+)
 
 type Symbol rune
 
@@ -22,20 +16,11 @@ const (
 	White        = '#'
 )
 
-type Direction int
-
-const (
-	DirUp Direction = iota
-	DirDown
-	DirLeft
-	DirRight
-)
-
 type Robot struct {
 	brain         *VM
-	region        map[Position]Symbol
-	currPosition  Position
-	currDirection Direction
+	region        *grid.Sparse[Symbol]
+	currPosition  grid.Point
+	currDirection grid.Direction
 }
 
 type VM struct {
@@ -224,50 +209,36 @@ func (vm *VM) executeCurrentInstruction() {
 	}
 }
 
-func part1(input []int64) int {
-	robot := Robot{brain: NewVM(), region: make(map[Position](Symbol))}
+func Part1(input []int64) int {
+	robot := Robot{brain: NewVM(), region: grid.NewSparse[Symbol]()}
 	// load the program into the robot memory
 	robot.brain.loadProgram(input)
-	robot.region[robot.currPosition] = Black
-	robot.currDirection = DirUp
+	robot.region.Set(robot.currPosition, Black)
+	robot.currDirection = grid.Up
 	robot.paint()
-	return len(robot.region)
+	return robot.region.Len()
 }
 
-func part2(input []int64) {
-	robot := Robot{brain: NewVM(), region: make(map[Position](Symbol))}
+// paint the hull starting from a white panel, the registration identifier is
+// painted in white on it
+func paintIdentifier(input []int64) []string {
+	robot := Robot{brain: NewVM(), region: grid.NewSparse[Symbol]()}
 	// load the program into the robot memory
 	robot.brain.loadProgram(input)
-	robot.region[robot.currPosition] = White
-	robot.currDirection = DirUp
+	robot.region.Set(robot.currPosition, White)
+	robot.currDirection = grid.Up
 	robot.paint()
-	xMin, yMin := math.MaxInt32, math.MaxInt32
-	xMax, yMax := math.MinInt32, math.MinInt32
-	for k := range robot.region {
-		if k.x < xMin {
-			xMin = k.x
-		}
-		if k.y < yMin {
-			yMin = k.y
-		}
-		if k.x > xMax {
-			xMax = k.x
-		}
-		if k.y > yMax {
-			yMax = k.y
-		}
-	}
 
-	for i := xMin; i <= xMax; i++ {
-		for j := yMin; j <= yMax; j++ {
-			if v, ok := robot.region[Position{x: i, y: j}]; !ok || v == Black {
-				fmt.Print(".")
-			} else {
-				fmt.Print("#")
-			}
+	return robot.region.Render(func(v Symbol) rune {
+		if v == White {
+			return '#'
 		}
-		fmt.Println()
-	}
+		return '.'
+	})
+}
+
+func Part2(input []int64) (string, error) {
+	return ocr.RecognizeRows(paintIdentifier(input))
 }
 
 func (r *Robot) getOutput() (output int64, done bool) {
@@ -293,9 +264,8 @@ func (r *Robot) getOutput() (output int64, done bool) {
 func (r *Robot) paint() {
 	// we need to distinguish between output paint color and next direction turn
 	paintColor := Black
-	nextTurn := DirLeft
 	for true {
-		if v, ok := r.region[r.currPosition]; v == Black || !ok {
+		if v, ok := r.region.Get(r.currPosition); v == Black || !ok {
 			r.brain.input = []int64{0}
 		} else {
 			r.brain.input = []int64{1}
@@ -315,125 +285,43 @@ func (r *Robot) paint() {
 			return
 		}
 
-		if output == 1 {
-			nextTurn = DirRight
-		} else {
-			nextTurn = DirLeft
-		}
-
 		// paint the current position
-		r.region[r.currPosition] = paintColor
-		// get the new current position and direction based on the output from the robot
-		r.currPosition = r.getNextPosition(nextTurn)
-		r.currDirection = r.getNextDirection(nextTurn)
-	}
-}
-
-func (r *Robot) getNextPosition(nextTurn Direction) Position {
-	newPos := r.currPosition
-	switch r.currDirection {
-	case DirUp:
-		if nextTurn == DirLeft {
-			newPos.y -= 1
-		} else {
-			newPos.y += 1
-		}
-	case DirDown:
-		if nextTurn == DirLeft {
-			newPos.y += 1
-		} else {
-			newPos.y -= 1
-		}
-	case DirLeft:
-		if nextTurn == DirLeft {
-			newPos.x += 1
-		} else {
-			newPos.x -= 1
-		}
-	case DirRight:
-		if nextTurn == DirLeft {
-			newPos.x -= 1
-		} else {
-			newPos.x += 1
-		}
-	default:
-		panic("Invalid direction received!")
-	}
-
-	return newPos
-}
-
-func (r *Robot) getNextDirection(nextTurn Direction) Direction {
-	newDirection := DirUp
-	switch r.currDirection {
-	case DirUp:
-		if nextTurn == DirLeft {
-			newDirection = DirLeft
-		} else {
-			newDirection = DirRight
-		}
-	case DirDown:
-		if nextTurn == DirLeft {
-			newDirection = DirRight
-		} else {
-			newDirection = DirLeft
-		}
-	case DirLeft:
-		if nextTurn == DirLeft {
-			newDirection = DirDown
-		} else {
-			newDirection = DirUp
-		}
-	case DirRight:
-		if nextTurn == DirLeft {
-			newDirection = DirUp
+		r.region.Set(r.currPosition, paintColor)
+		// turn and move one panel forward based on the output from the robot
+		if output == 1 {
+			r.currDirection = r.currDirection.TurnRight()
 		} else {
-			newDirection = DirDown
+			r.currDirection = r.currDirection.TurnLeft()
 		}
-	default:
-		panic("Invalid direction received!")
+		r.currPosition = r.currPosition.Move(r.currDirection)
 	}
-
-	return newDirection
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	program, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
-	}
-
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	// we have just one line, with elements separated by commas
-	if len(inputs) != 1 {
-		panic("The input should be only one line long!")
+	results := []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(program) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} {
+			var identifier string
+			identifier, err = Part2(program)
+			return identifier
+		}),
 	}
-
-	var program []int64
-	for _, integer := range strings.Split(inputs[0], ",") {
-		if val, err := strconv.ParseInt(integer, 10, 64); err != nil {
-			panic(err)
-		} else {
-			program = append(program, val)
-		}
-
+	if err != nil {
+		return nil, err
 	}
 
-	fmt.Println("The result to 1st part is: ", part1(program))
+	return results, nil
+}
 
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ")
-	part2(program)
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]int64, error) {
+	return parse.File(path, parse.Intcode)
 }
@@ -0,0 +1,17 @@
+package day11
+
+import (
+	"path/filepath"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+// Draw saves the hull painted with the registration identifier into dir
+func Draw(path, dir string, scale int) error {
+	input, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	return imaging.SavePNG(filepath.Join(dir, "day11-hull.png"), paintIdentifier(input), imaging.Mono, scale)
+}
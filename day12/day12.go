@@ -1,13 +1,10 @@
-package main
+package day12
 
 import (
-	"bufio"
-	"fmt"
-	"log"
 	"math"
-	"os"
-	"strconv"
-	"strings"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
 )
 
 type Position struct {
@@ -90,7 +87,7 @@ func generateCombinations(moons []Moon, currentCombination []Moon, combinations
 	}
 }
 
-func part1(moons []Moon) int {
+func Part1(moons []Moon) int {
 	for i := 0; i < 1000; i++ {
 		applyGravity(moons)
 		updateMoonsPosition(moons)
@@ -116,7 +113,7 @@ type PositionSpeed struct {
 	s3 int64
 }
 
-func part2(moons []Moon) int {
+func Part2(moons []Moon) int {
 	steps := 0
 	positionSpeedX := PositionSpeed{
 		p0: moons[0].position.x,
@@ -215,37 +212,32 @@ func lcm(a int, b int) int {
 	return (a * b) / gcd(a, b)
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	moons, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	defer file.Close()
+	moonsCopy := append([]Moon(nil), moons...)
 
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
-	}
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(moonsCopy) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(moons) }),
+	}, nil
+}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]Moon, error) {
+	positions, err := parse.File(path, parse.Vectors)
+	if err != nil {
+		return nil, err
 	}
 
 	var moons []Moon
-	for _, p := range inputs {
-		coordinates := strings.Split(strings.Trim(p, "<>"), ",")
-		p := [3]int64{0, 0, 0}
-		for i, c := range coordinates {
-			if val, err := strconv.ParseInt(strings.Trim(strings.Split(c, "=")[1], " "), 10, 64); err != nil {
-				panic(err)
-			} else {
-				p[i] = val
-			}
-		}
-
+	for _, p := range positions {
 		moons = append(moons, Moon{
 			position: Position{
 				x: p[0],
@@ -260,11 +252,5 @@ func main() {
 		})
 	}
 
-	moonsCopy := append([]Moon(nil), moons...)
-
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(moonsCopy))
-
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(moons))
+	return moons, nil
 }
@@ -1,12 +1,10 @@
-package main
+package day13
 
 import (
-	"bufio"
 	"fmt"
-	"log"
-	"os"
-	"strconv"
-	"strings"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
 )
 
 type Position struct {
@@ -28,6 +26,8 @@ type Cabinet struct {
 	currentPaddlePosition Position
 	currentBallPosition   Position
 	currentScore          int64
+	// called every time the ball moves, when set
+	onFrame func(c *Cabinet)
 }
 
 type VM struct {
@@ -216,7 +216,7 @@ func (vm *VM) executeCurrentInstruction() {
 	}
 }
 
-func part1(input []int64) int {
+func Part1(input []int64) int {
 	cabinet := Cabinet{vm: NewVM(), screen: make(map[Position](int64))}
 	// load the program into the cabinet memory
 	cabinet.vm.loadProgram(input)
@@ -230,7 +230,7 @@ func part1(input []int64) int {
 	return noBlocks
 }
 
-func part2(input []int64) int64 {
+func Part2(input []int64) int64 {
 	cabinet := Cabinet{vm: NewVM(), screen: make(map[Position](int64))}
 	// load the program into the cabinet memory
 	cabinet.vm.loadProgram(input)
@@ -301,45 +301,53 @@ func (c *Cabinet) run() {
 		} else {
 			c.screen[Position{x: x, y: y}] = id
 		}
+		if id == Ball && c.onFrame != nil {
+			c.onFrame(c)
+		}
 	}
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
-	if err != nil {
-		log.Fatal(err)
+// draw the screen as lines of text, one symbol for every tile id
+func (c *Cabinet) render() []string {
+	var height, width int64
+	for p := range c.screen {
+		if p.x+1 > height {
+			height = p.x + 1
+		}
+		if p.y+1 > width {
+			width = p.y + 1
+		}
 	}
 
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
+	symbols := []rune{' ', '#', '=', '_', 'o'}
+	rows := make([]string, height)
+	for x := range rows {
+		row := make([]rune, width)
+		for y := range row {
+			row[y] = symbols[c.screen[Position{x: int64(x), y: int64(y)}]]
+		}
+		rows[x] = string(row)
 	}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
-	}
+	return rows
+}
 
-	// we have just one line, with elements separated by commas
-	if len(inputs) != 1 {
-		panic("The input should be only one line long!")
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	program, err := ReadInput(path)
+	if err != nil {
+		return nil, err
 	}
 
-	var program []int64
-	for _, integer := range strings.Split(inputs[0], ",") {
-		if val, err := strconv.ParseInt(integer, 10, 64); err != nil {
-			panic(err)
-		} else {
-			program = append(program, val)
-		}
-
-	}
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(program))
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(program) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(program) }),
+	}, nil
+}
 
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(program))
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]int64, error) {
+	return parse.File(path, parse.Intcode)
 }
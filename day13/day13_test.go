@@ -0,0 +1,29 @@
+package day13
+
+import (
+	"testing"
+)
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		program, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part1(program)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		program, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part2(program)
+	}
+}
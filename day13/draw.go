@@ -0,0 +1,40 @@
+package day13
+
+import (
+	"image/color"
+	"path/filepath"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+var palette = imaging.Palette{
+	Background: color.Black,
+	Colors: map[rune]color.Color{
+		'#': color.RGBA{0x80, 0x80, 0x80, 0xff},
+		'=': color.RGBA{0x4a, 0x9c, 0xd9, 0xff},
+		'_': color.RGBA{0xff, 0xff, 0xff, 0xff},
+		'o': color.RGBA{0xff, 0xd0, 0x40, 0xff},
+	},
+}
+
+// Draw saves the game played until the last block is broken into dir as an
+// animation, one frame every time the ball moves
+func Draw(path, dir string, scale int) error {
+	input, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	game := imaging.NewAnimation(palette, scale, 2)
+	cabinet := Cabinet{vm: NewVM(), screen: make(map[Position](int64))}
+	cabinet.onFrame = func(c *Cabinet) {
+		game.Capture(c.render())
+	}
+	// load the program into the cabinet memory
+	cabinet.vm.loadProgram(input)
+	// insert coin
+	cabinet.vm.memory[0] = 2
+	cabinet.run()
+
+	return game.Save(filepath.Join(dir, "day13-game.gif"))
+}
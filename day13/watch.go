@@ -0,0 +1,44 @@
+package day13
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/stanciua/adventofcode2019/terminal"
+)
+
+// Watch plays the game on the terminal, redrawing the screen every time the
+// ball moves, together with the score and the blocks left
+func Watch(path string, w io.Writer, fps int) error {
+	input, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	screen := terminal.NewScreen(w, palette, fps)
+	defer screen.Close()
+
+	cabinet := Cabinet{vm: NewVM(), screen: make(map[Position](int64))}
+	draw := func(c *Cabinet) {
+		if err != nil {
+			return
+		}
+		blocks := 0
+		for _, tile := range c.screen {
+			if tile == Block {
+				blocks++
+			}
+		}
+		err = screen.Draw(c.render(), fmt.Sprintf("score: %d  blocks: %d", c.currentScore, blocks))
+	}
+	cabinet.onFrame = draw
+	// load the program into the cabinet memory
+	cabinet.vm.loadProgram(input)
+	// insert coin
+	cabinet.vm.memory[0] = 2
+	cabinet.run()
+	// the score of the last block comes after the last move of the ball
+	draw(&cabinet)
+
+	return err
+}
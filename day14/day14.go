@@ -1,12 +1,8 @@
-package main
+package day14
 
 import (
-	"bufio"
-	"fmt"
-	"log"
-	"os"
-	"strconv"
-	"strings"
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
 )
 
 type Chemical struct {
@@ -19,7 +15,7 @@ type Reaction struct {
 	output Chemical
 }
 
-func part1(reactions map[string]*Reaction) int64 {
+func Part1(reactions map[string]*Reaction) int64 {
 	produced := make(map[string]int64)
 	produced["FUEL"] = 1
 	reduceReaction(produced, reactions)
@@ -71,7 +67,7 @@ func getFuelNumber(adder int64, initialFuel int64, fuelReaction *Reaction, react
 
 	return initialFuel
 }
-func part2(reactions map[string]*Reaction) int64 {
+func Part2(reactions map[string]*Reaction) int64 {
 	c := int64(1)
 	fuelReaction := Reaction{
 		input:  append([]Chemical(nil), reactions["FUEL"].input...),
@@ -85,67 +81,42 @@ func part2(reactions map[string]*Reaction) int64 {
 	return c - 1
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	reactions, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
-	}
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(reactions) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(reactions) }),
+	}, nil
+}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (map[string]*Reaction, error) {
+	parsed, err := parse.File(path, parse.Reactions)
+	if err != nil {
+		return nil, err
 	}
 
 	reactions := make(map[string]*Reaction)
-	for _, p := range inputs {
-		reaction := strings.Split(p, "=>")
-		// output chemical processing
-		var outputChemical Chemical
-		nameQuantity := strings.Split(strings.Trim(reaction[1], " "), " ")
-		if val, err := strconv.ParseInt(nameQuantity[0], 10, 64); err != nil {
-			panic(err)
-		} else {
-			outputChemical = Chemical{
-				name:     strings.Trim(nameQuantity[1], " "),
-				quantity: val,
-			}
-		}
-
-		// input chemical processing
-		input := strings.Trim(reaction[0], " ")
-		inputChemicals := strings.Split(input, ",")
+	for _, r := range parsed {
 		var chemicals []Chemical
-		for _, c := range inputChemicals {
-			nameQuantity := strings.Split(strings.Trim(c, " "), " ")
-			if val, err := strconv.ParseInt(nameQuantity[0], 10, 64); err != nil {
-				panic(err)
-			} else {
-				chemical := Chemical{
-					name:     strings.Trim(nameQuantity[1], " "),
-					quantity: val,
-				}
-				chemicals = append(chemicals, chemical)
-			}
+		for _, q := range r.Inputs {
+			chemicals = append(chemicals, Chemical{name: q.Chemical, quantity: q.Amount})
 		}
 
 		chemicalReaction := &Reaction{
 			input:  chemicals,
-			output: outputChemical,
+			output: Chemical{name: r.Output.Chemical, quantity: r.Output.Amount},
 		}
 
 		reactions[chemicalReaction.output.name] = chemicalReaction
 	}
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(reactions))
 
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(reactions))
+	return reactions, nil
 }
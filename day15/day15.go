@@ -1,22 +1,16 @@
-package main
+package day15
 
 import (
-	"bufio"
 	"fmt"
-	"log"
-	"math"
-	"os"
-	"strconv"
-	"strings"
-)
 
-type Position struct {
-	y int64
-	x int64
-}
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/graph"
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
 
 type Neighbor struct {
-	pos    Position
+	pos    grid.Point
 	symbol rune
 	dir    int64
 }
@@ -42,11 +36,14 @@ const (
 
 var REVERSE_COMMAND = []int64{2, 1, 4, 3}
 
-var DIRECTIONS = []Position{{y: -1, x: 0}, {y: 1, x: 0}, {y: 0, x: -1}, {y: 0, x: 1}}
+// the direction the droid moves in for each movement command
+var commandDirection = map[int64]grid.Direction{North: grid.Up, South: grid.Down, West: grid.Left, East: grid.Right}
 
 type Droid struct {
 	vm   *VM
-	area map[Position]rune
+	area *grid.Sparse[rune]
+	// called with the position of the droid every time it moves, when set
+	onMove func(droid *Droid, pos grid.Point)
 }
 
 type VM struct {
@@ -235,152 +232,70 @@ func (vm *VM) executeCurrentInstruction() {
 	}
 }
 
-func part1(input []int64) int {
-	area := make(map[Position]rune)
-	droid := Droid{vm: NewVM(), area: area}
-	droid.vm.loadProgram(input)
-	startPosition := Position{y: 0, x: 0}
-	discovered := make(map[Position]bool)
-	oxygenPos := Position{y: 0, x: 0}
-	// build the map and find the Oxygen position
-	droid.buildMap(startPosition, discovered, &oxygenPos)
-	droid.area[startPosition] = KnownPosition
-	droid.area[oxygenPos] = KnownPosition
-	return droid.findMininumNoOfSteps(startPosition, oxygenPos)
-}
+// the droid starts at the origin of the area
+var startPosition = grid.Point{}
 
-func part2(input []int64) int {
-	area := make(map[Position]rune)
-	droid := Droid{vm: NewVM(), area: area}
+// let the droid explore the whole area, returning it together with the
+// position of the oxygen system
+func explore(input []int64, onMove func(droid *Droid, pos grid.Point)) (Droid, grid.Point) {
+	droid := Droid{vm: NewVM(), area: grid.NewSparse[rune](), onMove: onMove}
 	droid.vm.loadProgram(input)
-	startPosition := Position{y: 0, x: 0}
-	discovered := make(map[Position]bool)
-	oxygenPos := Position{y: 0, x: 0}
+	discovered := make(map[grid.Point]bool)
+	oxygenPos := grid.Point{}
 	// build the map and find the Oxygen position
 	droid.buildMap(startPosition, discovered, &oxygenPos)
-	droid.area[startPosition] = KnownPosition
-	droid.area[oxygenPos] = KnownPosition
-	return droid.fillWithOxygen(oxygenPos)
+	droid.area.Set(startPosition, KnownPosition)
+	droid.area.Set(oxygenPos, KnownPosition)
+	return droid, oxygenPos
 }
 
-func getMinValue(queue map[Position]bool, dist map[Position]int) (Position, int) {
-	min := math.MaxInt32
-	pos := Position{y: 0, x: 0}
-	for p, d := range dist {
-		if d < min && queue[p] {
-			min = d
-			pos = p
-		}
-	}
-
-	return pos, min
+func Part1(input []int64) int {
+	droid, oxygenPos := explore(input, nil)
+	return droid.findMininumNoOfSteps(startPosition, oxygenPos)
 }
 
-func (droid *Droid) findMininumNoOfSteps(source Position, destination Position) int {
-	dist := make(map[Position]int)
-	queue := make(map[Position]bool)
-
-	for pos, sym := range droid.area {
-		if sym != KnownPosition {
-			continue
-		}
-
-		dist[pos] = math.MaxInt32
-		queue[pos] = true
-	}
-
-	dist[source] = 0
-
-	for len(queue) > 0 {
-		// get u with min dist[u]
-		minPos, minDist := getMinValue(queue, dist)
-
-		if destination == minPos {
-			return minDist
-		}
-		delete(queue, minPos)
-
-		neighbors := droid.cellNeighbors(minPos)
-
-		for _, n := range neighbors {
-			if _, ok := queue[n]; !ok {
-				continue
-			}
+func Part2(input []int64) int {
+	droid, oxygenPos := explore(input, nil)
+	return droid.fillWithOxygen(oxygenPos)
+}
 
-			alt := minDist + 1
-			if alt < dist[n] && minDist != math.MaxInt32 {
-				dist[n] = alt
-			}
+func (droid *Droid) findMininumNoOfSteps(source grid.Point, destination grid.Point) int {
+	neighbors := func(pos grid.Point) []graph.Edge[grid.Point] {
+		edges := make([]graph.Edge[grid.Point], 0, 4)
+		for _, n := range droid.cellNeighbors(pos) {
+			edges = append(edges, graph.Edge[grid.Point]{To: n, Cost: 1})
 		}
+		return edges
 	}
 
-	return 0
+	steps, _ := graph.AStar(source, destination, neighbors, graph.Manhattan(destination)).Dist(destination)
+	return steps
 }
 
-func (droid *Droid) fillWithOxygen(source Position) int {
-	droid.area[source] = OxygenSymbol
-
-	// count the number of KnownPositions
-	count := 0
-	for _, sym := range droid.area {
-		if sym == KnownPosition {
-			count++
-		}
-	}
-
-	explored := make(map[Position]bool)
+// the oxygen spreads one location further every minute, so the time it takes
+// to fill the area is the distance to the farthest location
+func (droid *Droid) fillWithOxygen(source grid.Point) int {
 	minutes := 0
-	for count > 0 {
-		openLocations := make(map[Position]rune)
-		for pos, sym := range droid.area {
-			if sym == OxygenSymbol {
-				for _, n := range droid.cellNeighbors(pos) {
-					openLocations[n] = KnownPosition
-				}
-			}
-		}
-
-		// now fill every open location we found
-		for pos := range openLocations {
-			droid.area[pos] = OxygenSymbol
-			explored[pos] = true
-			count--
+	graph.BFS(source, droid.cellNeighbors, nil).Each(func(_ grid.Point, d int) {
+		if d > minutes {
+			minutes = d
 		}
-		minutes++
-	}
+	})
 
 	return minutes
 }
 
-func (droid *Droid) cellNeighbors(pos Position) []Position {
-	neighbors := make([]Position, 0)
-
-	// check up
-	p := Position{y: pos.y - 1, x: pos.x}
-	if droid.area[p] == KnownPosition {
-		neighbors = append(neighbors, p)
-	}
-	// check down
-	p = Position{y: pos.y + 1, x: pos.x}
-	if droid.area[p] == KnownPosition {
-		neighbors = append(neighbors, p)
-	}
-
-	// check left
-	p = Position{y: pos.y, x: pos.x - 1}
-	if droid.area[p] == KnownPosition {
-		neighbors = append(neighbors, p)
-	}
-
-	// check right
-	p = Position{y: pos.y, x: pos.x + 1}
-	if droid.area[p] == KnownPosition {
-		neighbors = append(neighbors, p)
+func (droid *Droid) cellNeighbors(pos grid.Point) []grid.Point {
+	neighbors := make([]grid.Point, 0)
+	for _, p := range pos.Neighbors4() {
+		if droid.area.At(p) == KnownPosition {
+			neighbors = append(neighbors, p)
+		}
 	}
 
 	return neighbors
-
 }
+
 func (droid *Droid) droidStatusReply(move int64) int64 {
 	output := int64(0)
 	// execute instruction as long as we don't have any output, input or the program is done
@@ -403,7 +318,7 @@ func (droid *Droid) droidStatusReply(move int64) int64 {
 	return output
 }
 
-func (droid *Droid) buildMap(source Position, discovered map[Position]bool, oxygenPos *Position) {
+func (droid *Droid) buildMap(source grid.Point, discovered map[grid.Point]bool, oxygenPos *grid.Point) {
 	discovered[source] = true
 
 	neighbors := droid.findNeighbors(source)
@@ -415,7 +330,7 @@ func (droid *Droid) buildMap(source Position, discovered map[Position]bool, oxyg
 			// mark all walls as already discovered
 			discovered[neighbor.pos] = true
 		}
-		droid.area[neighbor.pos] = neighbor.symbol
+		droid.area.Set(neighbor.pos, neighbor.symbol)
 	}
 
 	// now visit all the cells that have not been visited
@@ -428,20 +343,26 @@ func (droid *Droid) buildMap(source Position, discovered map[Position]bool, oxyg
 		_ = droid.droidStatusReply(neighbor.dir)
 
 		// before moving the droid, mark the cell as known location
-		droid.area[source] = KnownPosition
+		droid.area.Set(source, KnownPosition)
+		droid.moved(neighbor.pos)
 		droid.buildMap(neighbor.pos, discovered, oxygenPos)
 		// if we need to go back, we need to tell the droid to backtrack
 		_ = droid.droidStatusReply(REVERSE_COMMAND[neighbor.dir-1])
+		droid.moved(source)
 	}
 }
 
-func (droid *Droid) findNeighbors(pos Position) []Neighbor {
+func (droid *Droid) moved(pos grid.Point) {
+	if droid.onMove != nil {
+		droid.onMove(droid, pos)
+	}
+}
+
+func (droid *Droid) findNeighbors(pos grid.Point) []Neighbor {
 	neighbors := make([]Neighbor, 4)
 
 	for d := North; d <= East; d++ {
-		cPos := DIRECTIONS[d-1]
-		dPos := Position{y: pos.y + cPos.y, x: pos.x + cPos.x}
-		neighbors[d-1].pos = dPos
+		neighbors[d-1].pos = pos.Move(commandDirection[d])
 		neighbors[d-1].dir = d
 		// pass the coordinate to the robot and check their response
 		output := droid.droidStatusReply(d)
@@ -463,42 +384,22 @@ func (droid *Droid) findNeighbors(pos Position) []Neighbor {
 	return neighbors
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	program, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
-	}
-
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	// we have just one line, with elements separated by commas
-	if len(inputs) != 1 {
-		panic("The input should be only one line long!")
-	}
-
-	var program []int64
-	for _, integer := range strings.Split(inputs[0], ",") {
-		if val, err := strconv.ParseInt(integer, 10, 64); err != nil {
-			panic(err)
-		} else {
-			program = append(program, val)
-		}
-
-	}
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(program))
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(program) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(program) }),
+	}, nil
+}
 
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(program))
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]int64, error) {
+	return parse.File(path, parse.Intcode)
 }
@@ -0,0 +1,185 @@
+package day15
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+// the area of the example of part 2, the droid starting at the top left
+const example = ` ##
+#..##
+#.#..#
+#.O.#
+ ###`
+
+func readExample() (Droid, grid.Point) {
+	droid := Droid{area: grid.NewSparse[rune]()}
+	var oxygenPos grid.Point
+	for y, line := range strings.Split(example, "\n") {
+		for x, c := range line {
+			p := grid.Point{X: x, Y: y}
+			switch c {
+			case WallSymbol:
+				droid.area.Set(p, WallSymbol)
+			case OxygenSymbol:
+				oxygenPos = p
+				droid.area.Set(p, KnownPosition)
+			case KnownPosition:
+				droid.area.Set(p, KnownPosition)
+			}
+		}
+	}
+
+	return droid, oxygenPos
+}
+
+func TestExample(t *testing.T) {
+	droid, oxygenPos := readExample()
+	if steps := droid.findMininumNoOfSteps(grid.Point{X: 1, Y: 1}, oxygenPos); steps != 3 {
+		t.Errorf("Expected 3 steps got %d", steps)
+	}
+	if minutes := droid.fillWithOxygen(oxygenPos); minutes != 4 {
+		t.Errorf("Expected 4 minutes got %d", minutes)
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		program, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part1(program)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		program, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part2(program)
+	}
+}
+
+// the searches are compared on the explored area alone, without the droid
+// moving around the area, against the quadratic ones they replaced
+
+func exploreInput(b *testing.B) (Droid, grid.Point) {
+	program, err := ReadInput("input/part1.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return explore(program, nil)
+}
+
+func BenchmarkSteps(b *testing.B) {
+	droid, oxygenPos := exploreInput(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		droid.findMininumNoOfSteps(startPosition, oxygenPos)
+	}
+}
+
+func BenchmarkStepsQuadratic(b *testing.B) {
+	droid, oxygenPos := exploreInput(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		quadraticSteps(&droid, startPosition, oxygenPos)
+	}
+}
+
+func BenchmarkFill(b *testing.B) {
+	droid, oxygenPos := exploreInput(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		droid.fillWithOxygen(oxygenPos)
+	}
+}
+
+func BenchmarkFillQuadratic(b *testing.B) {
+	droid, oxygenPos := exploreInput(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		// the flood fills the area it is given
+		area := grid.NewSparse[rune]()
+		droid.area.Each(area.Set)
+		flooded := Droid{area: area}
+		b.StartTimer()
+		quadraticFill(&flooded, oxygenPos)
+	}
+}
+
+// the Dijkstra search scanning every location for the closest one
+func quadraticSteps(droid *Droid, source grid.Point, destination grid.Point) int {
+	dist := make(map[grid.Point]int)
+	queue := make(map[grid.Point]bool)
+	droid.area.Each(func(pos grid.Point, sym rune) {
+		if sym == KnownPosition {
+			dist[pos] = math.MaxInt32
+			queue[pos] = true
+		}
+	})
+	dist[source] = 0
+
+	for len(queue) > 0 {
+		minPos, minDist := grid.Point{}, math.MaxInt32
+		for p, d := range dist {
+			if d < minDist && queue[p] {
+				minPos, minDist = p, d
+			}
+		}
+		if minPos == destination {
+			return minDist
+		}
+		delete(queue, minPos)
+
+		for _, n := range droid.cellNeighbors(minPos) {
+			if queue[n] && minDist+1 < dist[n] {
+				dist[n] = minDist + 1
+			}
+		}
+	}
+
+	return 0
+}
+
+// the flood going through the whole area every minute
+func quadraticFill(droid *Droid, source grid.Point) int {
+	droid.area.Set(source, OxygenSymbol)
+	count := 0
+	droid.area.Each(func(_ grid.Point, sym rune) {
+		if sym == KnownPosition {
+			count++
+		}
+	})
+
+	minutes := 0
+	for count > 0 {
+		open := make(map[grid.Point]bool)
+		droid.area.Each(func(pos grid.Point, sym rune) {
+			if sym == OxygenSymbol {
+				for _, n := range droid.cellNeighbors(pos) {
+					open[n] = true
+				}
+			}
+		})
+		for pos := range open {
+			droid.area.Set(pos, OxygenSymbol)
+			count--
+		}
+		minutes++
+	}
+
+	return minutes
+}
@@ -0,0 +1,34 @@
+package day15
+
+import (
+	"image/color"
+	"path/filepath"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+var palette = imaging.Palette{
+	Background: color.Black,
+	Colors: map[rune]color.Color{
+		WallSymbol:    color.RGBA{0x80, 0x80, 0x80, 0xff},
+		KnownPosition: color.RGBA{0x20, 0x20, 0x30, 0xff},
+		OxygenSymbol:  color.RGBA{0x4a, 0x9c, 0xd9, 0xff},
+		'D':           color.RGBA{0xff, 0xd0, 0x40, 0xff},
+	},
+}
+
+// Draw saves the area explored by the droid into dir, with the starting
+// position of the droid and the oxygen system marked
+func Draw(path, dir string, scale int) error {
+	input, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	droid, oxygenPos := explore(input, nil)
+	droid.area.Set(startPosition, 'D')
+	droid.area.Set(oxygenPos, OxygenSymbol)
+	area := droid.area.Render(func(sym rune) rune { return sym })
+
+	return imaging.SavePNG(filepath.Join(dir, "day15-area.png"), area, palette, scale)
+}
@@ -0,0 +1,43 @@
+package day15
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/terminal"
+)
+
+// Watch follows the droid on the terminal while it explores the area, one
+// frame for every move
+func Watch(path string, w io.Writer, fps int) error {
+	input, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	screen := terminal.NewScreen(w, palette, fps)
+	defer screen.Close()
+
+	moves := 0
+	droid, oxygenPos := explore(input, func(droid *Droid, pos grid.Point) {
+		if err != nil {
+			return
+		}
+		moves++
+		area := droid.area.Render(func(sym rune) rune { return sym })
+		bounds := droid.area.Bounds()
+		row := []rune(area[pos.Y-bounds.Min.Y])
+		row[pos.X-bounds.Min.X] = 'D'
+		area[pos.Y-bounds.Min.Y] = string(row)
+		err = screen.Draw(area, fmt.Sprintf("moves: %d  explored: %d", moves, droid.area.Len()))
+	})
+	if err != nil {
+		return err
+	}
+
+	steps := droid.findMininumNoOfSteps(startPosition, oxygenPos)
+	droid.area.Set(oxygenPos, OxygenSymbol)
+	area := droid.area.Render(func(sym rune) rune { return sym })
+	return screen.Draw(area, fmt.Sprintf("moves: %d  oxygen system found %d steps away", moves, steps))
+}
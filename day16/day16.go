@@ -1,13 +1,13 @@
-package main
+package day16
 
 import (
-	"bufio"
 	"fmt"
-	"log"
 	"math"
-	"os"
 	"strconv"
 	"strings"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
 )
 
 var PATTERN = [...]int{0, 1, 0, -1}
@@ -76,7 +76,7 @@ func updateInputSignalOptimized(inputDigits []int, initialInputDigits []int, ite
 	}
 }
 
-func part1(inputSignal string) string {
+func Part1(inputSignal string) string {
 	inputDigits := getInputSignalDigits(inputSignal)
 	precomputedPatterns := precomputePatternsForEachDigit(len(inputDigits))
 	for i := 0; i < 100; i++ {
@@ -91,7 +91,7 @@ func part1(inputSignal string) string {
 	return strings.Join(output, "")
 }
 
-func part2(inputSignal string) string {
+func Part2(inputSignal string) string {
 	// get the offset first
 	offset, err := strconv.ParseInt(inputSignal[:7], 10, 0)
 	if err != nil {
@@ -118,34 +118,27 @@ func part2(inputSignal string) string {
 	return strings.Join(output, "")
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	inputSignal, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
+		return nil, err
 	}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
-	}
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(inputSignal) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(inputSignal) }),
+	}, nil
+}
 
-	// we have just one line, with elements separated by commas
-	if len(inputs) != 1 {
-		panic("The input should be only one line long!")
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (string, error) {
+	line, err := parse.File(path, parse.Single)
+	if err != nil {
+		return "", err
 	}
 
-	inputSignal := inputs[0]
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(inputSignal))
-
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(inputSignal))
+	return line.Text, nil
 }
@@ -1,25 +1,16 @@
-package main
+package day17
 
 import (
-	"bufio"
 	"fmt"
-	"log"
-	"os"
 	"sort"
 	"strconv"
 	"strings"
 	"unicode"
-)
-
-type Point struct {
-	y int
-	x int
-}
 
-type Neighbor struct {
-	pos    Point
-	symbol rune
-}
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
 
 const (
 	Scaffold     = '#'
@@ -34,7 +25,9 @@ const (
 
 type Robot struct {
 	vm   *VM
-	view [][]rune
+	view *grid.Dense[rune]
+	// called with every frame of the continuous video feed, when set
+	onFrame func(view []string)
 }
 
 type VM struct {
@@ -86,15 +79,9 @@ const (
 	Relative
 )
 
-const (
-	Up int = iota
-	Down
-	Left
-	Right
-)
-
-// UP, DOWN, LEFT, RIGHT
-var DIRECTIONS = []Point{{-1, 0}, {1, 0}, {0, -1}, {0, 1}}
+// the order the paths over the scaffolding are searched in, which decides the
+// movement routines found first, unlike the clockwise grid.Directions
+var searchOrder = [4]grid.Direction{grid.Up, grid.Down, grid.Left, grid.Right}
 
 func (vm *VM) hasFinished() bool {
 	return vm.stopped
@@ -239,9 +226,8 @@ func (vm *VM) executeCurrentInstruction() {
 	}
 }
 
-func part1(input []int64) int {
-	view := make([][]rune, 0)
-	robot := Robot{vm: NewVM(), view: view}
+func Part1(input []int64) int {
+	robot := Robot{vm: NewVM()}
 	robot.vm.loadProgram(input)
 	// build the map and find the Oxygen position
 	robot.buildView()
@@ -253,36 +239,48 @@ func part1(input []int64) int {
 	return sum
 }
 
-func part2(input []int64) int {
+// find the movement routines that take the vacuum robot over the whole
+// scaffolding, returning them together with the view of the cameras
+func findRoutines(input []int64) (view *grid.Dense[rune], main, a, b, c []int64, found bool) {
 	// instantiate a robot to find the paths
-	view := make([][]rune, 0)
-	robot := Robot{vm: NewVM(), view: view}
+	robot := Robot{vm: NewVM()}
 	robot.vm.loadProgram(input)
 	robot.buildView()
 	start, end := robot.findStartEndPositions()
-	currentPath := make([]Point, 0)
+	currentPath := make([]grid.Point, 0)
 	currentPath = append(currentPath, start)
-	path := make([]Point, 0)
-	visited := make(map[Point]bool)
-	prev := Point{0, 0}
+	path := make([]grid.Point, 0)
+	visited := make(map[grid.Point]bool)
+	prev := grid.Point{}
 	scaffolds := robot.getScaffolds()
-	paths := make([][]Point, 0)
+	paths := make([][]grid.Point, 0)
 	robot.findPaths(start, end, path, visited, prev, scaffolds, &paths)
-	// wake up the robot
-	robot = Robot{vm: NewVM(), view: view}
-	robot.vm.loadProgram(input)
-	robot.vm.memory[0] = 2
-	output := int64(0)
 	for _, p := range paths {
-		translatedPath := translatePath(p, RobotUp)
+		translatedPath := translatePath(p, grid.Up)
 		splitedPath := compressPathTo3Movements(translatedPath)
 		if len(splitedPath) > 0 {
-			main, a, b, c := getRoutines(splitedPath)
-			output = robot.runVacuumRobot(main, a, b, c)
-			break
+			main, a, b, c = getRoutines(splitedPath)
+			return robot.view, main, a, b, c, true
 		}
 	}
-	return int(output)
+	return robot.view, nil, nil, nil, nil, false
+}
+
+// wake up the robot and let it clean the scaffolding following the routines,
+// returning the amount of dust collected
+func wakeUp(input []int64, view *grid.Dense[rune], main, a, b, c []int64, onFrame func(view []string)) int64 {
+	robot := Robot{vm: NewVM(), view: view, onFrame: onFrame}
+	robot.vm.loadProgram(input)
+	robot.vm.memory[0] = 2
+	return robot.runVacuumRobot(main, a, b, c)
+}
+
+func Part2(input []int64) int {
+	view, main, a, b, c, found := findRoutines(input)
+	if !found {
+		return 0
+	}
+	return int(wakeUp(input, view, main, a, b, c, nil))
 }
 
 func (robot *Robot) runVacuumRobot(main []int64, a []int64, b []int64, c []int64) int64 {
@@ -292,8 +290,15 @@ func (robot *Robot) runVacuumRobot(main []int64, a []int64, b []int64, c []int64
 	input = append(input, a...)
 	input = append(input, b...)
 	input = append(input, c...)
-	input = append(input, []int64{int64('n'), int64('\n')}...)
+	// the continuous video feed is only needed to watch the robot
+	feed := int64('n')
+	if robot.onFrame != nil {
+		feed = 'y'
+	}
+	input = append(input, []int64{feed, int64('\n')}...)
 
+	var line []rune
+	var frame []string
 	for {
 		robot.vm.currInstruction = robot.vm.decodeCurrentInstruction()
 		if robot.vm.currInstruction.opcode == Input {
@@ -306,6 +311,21 @@ func (robot *Robot) runVacuumRobot(main []int64, a []int64, b []int64, c []int64
 
 		if robot.vm.outputReady {
 			robot.vm.outputReady = false
+			// the frames of the video feed are separated by empty lines, the
+			// prompts for the routines are skipped
+			if robot.onFrame != nil && robot.vm.output < 128 {
+				if robot.vm.output != NewLine {
+					line = append(line, rune(robot.vm.output))
+				} else if len(line) > 0 {
+					frame = append(frame, string(line))
+					line = nil
+				} else if len(frame) > 0 {
+					if len(frame[0]) == robot.view.Width() {
+						robot.onFrame(frame)
+					}
+					frame = nil
+				}
+			}
 		}
 
 		if robot.vm.hasFinished() {
@@ -316,7 +336,7 @@ func (robot *Robot) runVacuumRobot(main []int64, a []int64, b []int64, c []int64
 	return output
 }
 
-func findInPath(path []Point, p Point) bool {
+func findInPath(path []grid.Point, p grid.Point) bool {
 	for _, pi := range path {
 		if p == pi {
 			return true
@@ -345,22 +365,20 @@ func (robot *Robot) robotCameraOutput() (done bool, output rune) {
 	return done, output
 }
 
-func (robot *Robot) findStartEndPositions() (start Point, end Point) {
-	for i := 0; i < len(robot.view); i++ {
-		for j := 0; j < len(robot.view[i]); j++ {
-			if robot.view[i][j] == RobotUp {
-				start = Point{i, j}
-			} else if robot.view[i][j] == Scaffold && len(robot.findNeighbors(Point{i, j})) == 1 {
-				end = Point{i, j}
-			}
+func (robot *Robot) findStartEndPositions() (start grid.Point, end grid.Point) {
+	robot.view.Each(func(p grid.Point, sym rune) {
+		if sym == RobotUp {
+			start = p
+		} else if sym == Scaffold && len(robot.findNeighbors(p)) == 1 {
+			end = p
 		}
-	}
+	})
 
 	return start, end
 }
 
-func (robot *Robot) isScaffold(p Point) bool {
-	sym := robot.view[p.y][p.x]
+func (robot *Robot) isScaffold(p grid.Point) bool {
+	sym := robot.view.At(p)
 	if sym == Scaffold || sym == RobotUp || sym == RobotDown || sym == RobotLeft || sym == RobotRight || sym == Intersection {
 		return true
 	}
@@ -368,25 +386,18 @@ func (robot *Robot) isScaffold(p Point) bool {
 	return false
 }
 
-func (robot *Robot) findNeighbors(p Point) []Point {
-	neighbors := make([]Point, 0)
-	if p.y-1 >= 0 && robot.isScaffold(Point{p.y - 1, p.x}) {
-		neighbors = append(neighbors, Point{p.y - 1, p.x})
-	}
-	if p.y+1 < len(robot.view) && robot.isScaffold(Point{p.y + 1, p.x}) {
-		neighbors = append(neighbors, Point{p.y + 1, p.x})
-	}
-	if p.x-1 >= 0 && robot.isScaffold(Point{p.y, p.x - 1}) {
-		neighbors = append(neighbors, Point{p.y, p.x - 1})
-	}
-	if p.x+1 < len(robot.view[p.y]) && robot.isScaffold(Point{p.y, p.x + 1}) {
-		neighbors = append(neighbors, Point{p.y, p.x + 1})
+func (robot *Robot) findNeighbors(p grid.Point) []grid.Point {
+	neighbors := make([]grid.Point, 0)
+	for _, d := range searchOrder {
+		if n := p.Move(d); robot.view.In(n) && robot.isScaffold(n) {
+			neighbors = append(neighbors, n)
+		}
 	}
 
 	return neighbors
 }
 
-func (robot *Robot) findPaths(start, end Point, path []Point, visited map[Point]bool, prev Point, scaffolds []Point, paths *[][]Point) {
+func (robot *Robot) findPaths(start, end grid.Point, path []grid.Point, visited map[grid.Point]bool, prev grid.Point, scaffolds []grid.Point, paths *[][]grid.Point) {
 	path = append(path, start)
 	visited[start] = true
 
@@ -401,7 +412,7 @@ func (robot *Robot) findPaths(start, end Point, path []Point, visited map[Point]
 			}
 
 			if validPath {
-				*paths = append(*paths, append([]Point(nil), path...))
+				*paths = append(*paths, append([]grid.Point(nil), path...))
 			}
 		}
 	} else {
@@ -431,113 +442,37 @@ func (robot *Robot) findPaths(start, end Point, path []Point, visited map[Point]
 	delete(visited, start)
 }
 
-func (robot *Robot) getScaffolds() []Point {
-	scaffolds := make([]Point, 0)
-	for i, line := range robot.view {
-		for j, s := range line {
-			if s == Scaffold || s == RobotUp {
-				scaffolds = append(scaffolds, Point{i, j})
-			}
-		}
-	}
-
-	return scaffolds
+func (robot *Robot) getScaffolds() []grid.Point {
+	return robot.view.Find(func(s rune) bool {
+		return s == Scaffold || s == RobotUp
+	})
 }
 
-func getDirectionFromPoint(p Point) int {
-	for idx, d := range DIRECTIONS {
-		if p == d {
-			return idx
-		}
-	}
-	panic("invalid value for point")
-}
-
-func translatePath(path []Point, robotDirection rune) []string {
+func translatePath(path []grid.Point, robotDirection grid.Direction) []string {
 	translatedPath := make([]string, 0)
 
 	prev := path[0]
 	path = path[1:]
 	count := 0
 	for _, p := range path {
-		newDir := Point{p.y - prev.y, p.x - prev.x}
-		switch robotDirection {
-		case RobotUp:
-			switch getDirectionFromPoint(newDir) {
-			case Up:
-				count++
-			case Left:
-				if count > 0 {
-					translatedPath = append(translatedPath, strconv.Itoa(count+1))
-					count = 0
-				}
-				translatedPath = append(translatedPath, "L")
-				robotDirection = RobotLeft
-			case Right:
-				if count > 0 {
-					translatedPath = append(translatedPath, strconv.Itoa(count+1))
-					count = 0
-				}
-				translatedPath = append(translatedPath, "R")
-				robotDirection = RobotRight
+		newDir, ok := grid.DirectionOf(p.Sub(prev))
+		if !ok {
+			panic("invalid value for point")
+		}
+		switch newDir {
+		case robotDirection:
+			count++
+		case robotDirection.TurnLeft(), robotDirection.TurnRight():
+			if count > 0 {
+				translatedPath = append(translatedPath, strconv.Itoa(count+1))
+				count = 0
 			}
-		case RobotDown:
-			switch getDirectionFromPoint(newDir) {
-			case Down:
-				count++
-			case Left:
-				if count > 0 {
-					translatedPath = append(translatedPath, strconv.Itoa(count+1))
-					count = 0
-				}
-				translatedPath = append(translatedPath, "R")
-				robotDirection = RobotLeft
-			case Right:
-				if count > 0 {
-					translatedPath = append(translatedPath, strconv.Itoa(count+1))
-					count = 0
-				}
+			if newDir == robotDirection.TurnLeft() {
 				translatedPath = append(translatedPath, "L")
-				robotDirection = RobotRight
-			}
-		case RobotLeft:
-			switch getDirectionFromPoint(newDir) {
-			case Left:
-				count++
-			case Up:
-				if count > 0 {
-					translatedPath = append(translatedPath, strconv.Itoa(count+1))
-					count = 0
-				}
+			} else {
 				translatedPath = append(translatedPath, "R")
-				robotDirection = RobotUp
-			case Down:
-				if count > 0 {
-					translatedPath = append(translatedPath, strconv.Itoa(count+1))
-					count = 0
-				}
-				translatedPath = append(translatedPath, "L")
-				robotDirection = RobotDown
-			}
-		case RobotRight:
-			switch getDirectionFromPoint(newDir) {
-			case Right:
-				count++
-			case Up:
-				if count > 0 {
-					translatedPath = append(translatedPath, strconv.Itoa(count+1))
-					count = 0
-				}
-				translatedPath = append(translatedPath, "L")
-				robotDirection = RobotUp
-			case Down:
-				if count > 0 {
-					translatedPath = append(translatedPath, strconv.Itoa(count+1))
-					count = 0
-				}
-				translatedPath = append(translatedPath, "R")
-				robotDirection = RobotDown
 			}
+			robotDirection = newDir
 		}
 		prev = p
 	}
@@ -733,99 +668,87 @@ func compress(currentPath *[]string, movementsList []string, path []string, part
 
 func (robot *Robot) buildView() {
 	i := 0
-	robot.view = append(robot.view, make([]rune, 0))
+	view := [][]rune{make([]rune, 0)}
 	for done, output := robot.robotCameraOutput(); !done; done, output = robot.robotCameraOutput() {
 		if output == NewLine {
 			i++
-			robot.view = append(robot.view, make([]rune, 0))
+			view = append(view, make([]rune, 0))
 		} else {
-			robot.view[i] = append(robot.view[i], output)
+			view[i] = append(view[i], output)
 		}
 	}
 
 	// the robot sends two new lines at the end, remove them from the view
-	robot.view = robot.view[:len(robot.view)-2]
+	view = view[:len(view)-2]
+	width := 0
+	for _, row := range view {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+	// rows cut short by the cameras are open space at the end
+	robot.view = grid.NewDense(width, len(view), OpenSpace)
+	for y, row := range view {
+		for x, sym := range row {
+			robot.view.Set(grid.Point{X: x, Y: y}, sym)
+		}
+	}
 }
 
 func (robot *Robot) displayView() {
-	for _, row := range robot.view {
-		for _, col := range row {
-			fmt.Print(string(col))
-		}
-		fmt.Println()
+	for _, row := range robot.view.Render(func(sym rune) rune { return sym }) {
+		fmt.Println(row)
 	}
 }
 
-func (robot *Robot) isIntersectionPoint(point Point) bool {
-	up := point.y-1 >= 0 && robot.view[point.y-1][point.x] == Scaffold
-	down := point.y+1 < len(robot.view) && robot.view[point.y+1][point.x] == Scaffold
-	left := point.x-1 >= 0 && robot.view[point.y][point.x-1] == Scaffold
-	right := point.x+1 < len(robot.view[point.y]) && robot.view[point.y][point.x+1] == Scaffold
-
-	return up && down && left && right && robot.view[point.y][point.x] != OpenSpace
+func (robot *Robot) isIntersectionPoint(point grid.Point) bool {
+	for _, n := range point.Neighbors4() {
+		if !robot.view.In(n) || robot.view.At(n) != Scaffold {
+			return false
+		}
+	}
 
+	return robot.view.At(point) != OpenSpace
 }
 
-func (robot *Robot) findIntersectionPoints() map[Point]bool {
-	intersections := make(map[Point]bool)
-	for i := 0; i < len(robot.view); i++ {
-		for j := 0; j < len(robot.view[i]); j++ {
-			point := Point{y: i, x: j}
-			if robot.isIntersectionPoint(point) {
-				intersections[point] = true
-				robot.view[i][j] = Intersection
-			}
+func (robot *Robot) findIntersectionPoints() map[grid.Point]bool {
+	intersections := make(map[grid.Point]bool)
+	robot.view.Each(func(point grid.Point, _ rune) {
+		if robot.isIntersectionPoint(point) {
+			intersections[point] = true
+			robot.view.Set(point, Intersection)
 		}
-	}
+	})
 
 	return intersections
 }
 
-func (robot *Robot) computeAligments(intersectionPoints map[Point]bool) []int {
+func (robot *Robot) computeAligments(intersectionPoints map[grid.Point]bool) []int {
 	aligments := make([]int, 0)
 
 	for p := range intersectionPoints {
-		aligments = append(aligments, p.y*p.x)
+		aligments = append(aligments, p.X*p.Y)
 	}
 
 	return aligments
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	program, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
-	}
-
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
-	}
-
-	// we have just one line, with elements separated by commas
-	if len(inputs) != 1 {
-		panic("The input should be only one line long!")
+		return nil, err
 	}
 
-	var program []int64
-	for _, integer := range strings.Split(inputs[0], ",") {
-		if val, err := strconv.ParseInt(integer, 10, 64); err != nil {
-			panic(err)
-		} else {
-			program = append(program, val)
-		}
-	}
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(program))
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(program) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(program) }),
+	}, nil
+}
 
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(program))
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]int64, error) {
+	return parse.File(path, parse.Intcode)
 }
@@ -0,0 +1,39 @@
+package day17
+
+import (
+	"image/color"
+	"path/filepath"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+var palette = imaging.Palette{
+	Background: color.Black,
+	Colors: map[rune]color.Color{
+		Scaffold:     color.RGBA{0x80, 0x80, 0x80, 0xff},
+		Intersection: color.RGBA{0x4a, 0x9c, 0xd9, 0xff},
+		RobotUp:      color.RGBA{0xff, 0xd0, 0x40, 0xff},
+		RobotDown:    color.RGBA{0xff, 0xd0, 0x40, 0xff},
+		RobotLeft:    color.RGBA{0xff, 0xd0, 0x40, 0xff},
+		RobotRight:   color.RGBA{0xff, 0xd0, 0x40, 0xff},
+	},
+}
+
+// Draw saves the scaffolding seen by the cameras into dir, with the
+// intersections and the vacuum robot marked
+func Draw(path, dir string, scale int) error {
+	input, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	robot := Robot{vm: NewVM()}
+	robot.vm.loadProgram(input)
+	robot.buildView()
+	for p := range robot.findIntersectionPoints() {
+		robot.view.Set(p, Intersection)
+	}
+	view := robot.view.Render(func(sym rune) rune { return sym })
+
+	return imaging.SavePNG(filepath.Join(dir, "day17-scaffolding.png"), view, palette, scale)
+}
@@ -0,0 +1,47 @@
+package day17
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/stanciua/adventofcode2019/terminal"
+)
+
+// Watch shows the scaffolding on the terminal while the movement routines are
+// searched and then follows the vacuum robot through the continuous video feed
+func Watch(path string, w io.Writer, fps int) error {
+	input, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	screen := terminal.NewScreen(w, palette, fps)
+	defer screen.Close()
+
+	robot := Robot{vm: NewVM()}
+	robot.vm.loadProgram(input)
+	robot.buildView()
+	last := robot.view.Render(func(sym rune) rune { return sym })
+	if err := screen.Draw(last, "searching for the movement routines..."); err != nil {
+		return err
+	}
+
+	view, main, a, b, c, found := findRoutines(input)
+	if !found {
+		return fmt.Errorf("no movement routines found for the scaffolding")
+	}
+	frames := 0
+	dust := wakeUp(input, view, main, a, b, c, func(view []string) {
+		if err != nil {
+			return
+		}
+		frames++
+		last = view
+		err = screen.Draw(view, fmt.Sprintf("frame %d", frames))
+	})
+	if err != nil {
+		return err
+	}
+
+	return screen.Draw(last, fmt.Sprintf("frame %d  dust collected: %d", frames, dust))
+}
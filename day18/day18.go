@@ -1,19 +1,18 @@
-package main
+package day18
 
 import (
-	"bufio"
-	"fmt"
-	"log"
 	"math"
-	"os"
 	"sort"
 	"unicode"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/graph"
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
 )
 
-type Vertex struct {
-	y int
-	x int
-}
+// the open cells of the vault are the vertices of its graph
+type Vertex = grid.Point
 
 type Edge struct {
 	v1 Vertex
@@ -26,15 +25,11 @@ const (
 	OpenPassage rune = '.'
 )
 
-func findEntrance(m [][]rune) Vertex {
-	for i, line := range m {
-		for j, c := range line {
-			if c == Entrance {
-				return Vertex{i, j}
-			}
-		}
+func findEntrance(m *grid.Dense[rune]) Vertex {
+	if entrances := m.Find(func(c rune) bool { return c == Entrance }); len(entrances) > 0 {
+		return entrances[0]
 	}
-	return Vertex{-1, -1}
+	return Vertex{X: -1, Y: -1}
 }
 
 func isMoveAllowed(s rune, doorsOpened bool, visitedKeys map[rune]bool) bool {
@@ -54,63 +49,27 @@ func isMoveAllowed(s rune, doorsOpened bool, visitedKeys map[rune]bool) bool {
 	return true
 }
 
-func findNeighbors(p Vertex, m [][]rune, visitedKeys map[rune]bool, doorsOpened bool) []Vertex {
+func findNeighbors(p Vertex, m *grid.Dense[rune], visitedKeys map[rune]bool, doorsOpened bool) []Vertex {
 	neighbors := make([]Vertex, 0)
-	pl := Vertex{p.y - 1, p.x}
-	if p.y-1 >= 0 && isMoveAllowed(m[pl.y][pl.x], doorsOpened, visitedKeys) {
-		neighbors = append(neighbors, pl)
-	}
-	pl = Vertex{p.y + 1, p.x}
-	if p.y+1 < len(m) && isMoveAllowed(m[pl.y][pl.x], doorsOpened, visitedKeys) {
-		neighbors = append(neighbors, pl)
-	}
-	pl = Vertex{p.y, p.x - 1}
-	if p.x-1 >= 0 && isMoveAllowed(m[pl.y][pl.x], doorsOpened, visitedKeys) {
-		neighbors = append(neighbors, pl)
-	}
-	pl = Vertex{p.y, p.x + 1}
-	if p.x+1 < len(m[p.y]) && isMoveAllowed(m[pl.y][pl.x], doorsOpened, visitedKeys) {
-		neighbors = append(neighbors, pl)
+	for _, d := range grid.Directions {
+		if pl := p.Move(d); m.In(pl) && isMoveAllowed(m.At(pl), doorsOpened, visitedKeys) {
+			neighbors = append(neighbors, pl)
+		}
 	}
 
 	return neighbors
 }
 
-func findIndex(Q []Vertex, p Vertex) (bool, int) {
-	for i, q := range Q {
-		if q == p {
-			return true, i
-		}
+func isKeyReachable(m *grid.Dense[rune], source Vertex, destination Vertex, visitedKeys map[rune]bool) bool {
+	neighbors := func(v Vertex) []Vertex {
+		return findNeighbors(v, m, visitedKeys, false)
 	}
+	_, found := graph.BFS(source, neighbors, func(v Vertex) bool { return v == destination }).Found()
 
-	return false, -1
+	return found
 }
 
-func isKeyReachable(m [][]rune, source Vertex, destination Vertex, visitedKeys map[rune]bool) bool {
-	Q := make([]Vertex, 0)
-	explored := make(map[Vertex]bool)
-	explored[source] = true
-	Q = append(Q, source)
-
-	for len(Q) > 0 {
-		v := Q[0]
-		Q = Q[1:]
-		if v == destination {
-			return true
-		}
-
-		for _, w := range findNeighbors(v, m, visitedKeys, false) {
-			if !explored[w] {
-				explored[w] = true
-				Q = append(Q, w)
-			}
-		}
-	}
-
-	return false
-}
-
-func reachableKeysBitSet(key Vertex, remainingKeysVi map[Vertex]int, remainingKeysIv map[int]Vertex, remainingKeysBitSet int, m [][]rune, visitedKeys map[rune]bool) int {
+func reachableKeysBitSet(key Vertex, remainingKeysVi map[Vertex]int, remainingKeysIv map[int]Vertex, remainingKeysBitSet int, m *grid.Dense[rune], visitedKeys map[rune]bool) int {
 	reachable := 0
 
 	for i := 0; remainingKeysBitSet != 0; i++ {
@@ -129,7 +88,7 @@ type CacheKey struct {
 	remainingKeys int
 }
 
-func minDistance1Robot(m [][]rune, distances map[Edge]int, currentKey Vertex, remainingKeysVi map[Vertex]int, remainingKeysIv map[int]Vertex, remainingKeysBitSet int, visitedKeys map[rune]bool, cache map[CacheKey]int) int {
+func minDistance1Robot(m *grid.Dense[rune], distances map[Edge]int, currentKey Vertex, remainingKeysVi map[Vertex]int, remainingKeysIv map[int]Vertex, remainingKeysBitSet int, visitedKeys map[rune]bool, cache map[CacheKey]int) int {
 	if remainingKeysBitSet == 0 {
 		return 0
 	}
@@ -149,7 +108,7 @@ func minDistance1Robot(m [][]rune, distances map[Edge]int, currentKey Vertex, re
 			mask := 1 << i
 			remainingKeysBitSet &= ^mask
 			p := remainingKeysIv[i]
-			visitedKeys[m[p.y][p.x]] = true
+			visitedKeys[m.At(p)] = true
 			distance := 0
 			if d, ok := distances[Edge{currentKey, remainingKeysIv[i]}]; ok {
 				distance = d
@@ -159,7 +118,7 @@ func minDistance1Robot(m [][]rune, distances map[Edge]int, currentKey Vertex, re
 				panic("invalid edge present!")
 			}
 			distance += minDistance1Robot(m, distances, remainingKeysIv[i], remainingKeysVi, remainingKeysIv, remainingKeysBitSet, visitedKeys, cache)
-			delete(visitedKeys, m[p.y][p.x])
+			delete(visitedKeys, m.At(p))
 			remainingKeysBitSet |= mask
 
 			if distance < min {
@@ -174,66 +133,34 @@ func minDistance1Robot(m [][]rune, distances map[Edge]int, currentKey Vertex, re
 	return min
 }
 
-func dijkstra(m [][]rune, source Vertex, destination Vertex, k map[rune]Vertex) int {
-	dist := make(map[Vertex]int)
-	Q := make([]Vertex, 0)
-
-	for i := range m {
-		for j := range m[i] {
-			dist[Vertex{i, j}] = math.MaxInt
-		}
-	}
-
-	Q = append(Q, source)
-
-	dist[source] = 0
+func dijkstra(m *grid.Dense[rune], source Vertex, destination Vertex, k map[rune]Vertex) int {
 	visitedKeys := make(map[rune]bool)
-	for len(Q) > 0 {
-		u, idx := findMinDistance(Q, dist)
-		if u == destination {
-			return dist[u]
-		}
-
-		Q = append(Q[:idx], Q[idx+1:]...)
-
+	neighbors := func(u Vertex) []graph.Edge[Vertex] {
+		edges := make([]graph.Edge[Vertex], 0, 4)
 		for _, v := range findNeighbors(u, m, visitedKeys, true) {
-			alt := dist[u] + 1
-			if alt < dist[v] {
-				dist[v] = alt
-				Q = append(Q, v)
-			}
+			edges = append(edges, graph.Edge[Vertex]{To: v, Cost: 1})
 		}
+		return edges
 	}
-	return -1
-}
 
-func findMinDistance(Q []Vertex, dist map[Vertex]int) (Vertex, int) {
-	min := math.MaxInt
-	p := Vertex{-1, -1}
-	idx := 0
-	for k, v := range dist {
-		if found, i := findIndex(Q, k); found && v <= min {
-			min = v
-			p = k
-			idx = i
-		}
+	paths := graph.Dijkstra(source, neighbors, func(u Vertex) bool { return u == destination })
+	if d, ok := paths.Dist(destination); ok {
+		return d
 	}
-	return p, idx
+	return -1
 }
 
-func findKeys(m [][]rune) map[rune]Vertex {
+func findKeys(m *grid.Dense[rune]) map[rune]Vertex {
 	keys := make(map[rune]Vertex)
-	for i, line := range m {
-		for j, s := range line {
-			if unicode.IsLower(s) {
-				keys[s] = Vertex{i, j}
-			}
+	m.Each(func(p Vertex, s rune) {
+		if unicode.IsLower(s) {
+			keys[s] = p
 		}
-	}
+	})
 	return keys
 }
 
-func calcDist1Robot(m [][]rune, keysList []Vertex, keys map[rune]Vertex) map[Edge]int {
+func calcDist1Robot(m *grid.Dense[rune], keysList []Vertex, keys map[rune]Vertex) map[Edge]int {
 	distances := make(map[Edge]int)
 	for i := 0; i < len(keysList); i++ {
 		for j := 0; j < len(keysList); j++ {
@@ -287,7 +214,7 @@ func robotData(entrances []Vertex, keys map[rune]Vertex, visitedKeys map[rune]bo
 	return sourcePoints, remainingKeysVi, remainingKeysIv, remainingKeysBitSet
 }
 
-func calcDist4Robots(remainingKeysVi map[Vertex]int, remainingKeysIv map[int]Vertex, remainingKeysBitSet int, m [][]rune, visitedKeys map[rune]bool, startPositions [4]Vertex, keys map[rune]Vertex) map[Edge]int {
+func calcDist4Robots(remainingKeysVi map[Vertex]int, remainingKeysIv map[int]Vertex, remainingKeysBitSet int, m *grid.Dense[rune], visitedKeys map[rune]bool, startPositions [4]Vertex, keys map[rune]Vertex) map[Edge]int {
 	distances := make(map[Edge]int)
 	for _, e := range startPositions {
 		keysPerRobot := make([]Vertex, 0)
@@ -332,7 +259,7 @@ type RobotsMoveCacheKey struct {
 	notSeenKeys int
 }
 
-func minDistance4Robots(m [][]rune, distances map[Edge]int, currentKey Vertex, remainingKeysVi map[Vertex]int, remainingKeysIv map[int]Vertex, remainingKeysBitSet int, visitedKeys map[rune]bool, cache map[RobotsMoveCacheKey]int, robotsPos [4]Vertex) int {
+func minDistance4Robots(m *grid.Dense[rune], distances map[Edge]int, currentKey Vertex, remainingKeysVi map[Vertex]int, remainingKeysIv map[int]Vertex, remainingKeysBitSet int, visitedKeys map[rune]bool, cache map[RobotsMoveCacheKey]int, robotsPos [4]Vertex) int {
 	if remainingKeysBitSet == 0 {
 		return 0
 	}
@@ -374,12 +301,12 @@ func minDistance4Robots(m [][]rune, distances map[Edge]int, currentKey Vertex, r
 	for _, s := range robotState {
 		p := remainingKeysIv[s.reachablePos]
 		robotsPos[s.id] = p
-		visitedKeys[m[p.y][p.x]] = true
+		visitedKeys[m.At(p)] = true
 		mask := 1 << s.reachablePos
 		remainingKeysBitSet &= ^mask
 		currDist := s.dist
 		currDist += minDistance4Robots(m, distances, remainingKeysIv[0], remainingKeysVi, remainingKeysIv, remainingKeysBitSet, visitedKeys, cache, robotsPos)
-		delete(visitedKeys, m[p.y][p.x])
+		delete(visitedKeys, m.At(p))
 		remainingKeysBitSet |= mask
 		robotsPos = oldRobotsPos
 
@@ -394,31 +321,25 @@ func minDistance4Robots(m [][]rune, distances map[Edge]int, currentKey Vertex, r
 	return minDistance
 }
 
-func updateMap(e Vertex, m [][]rune) (Vertex, Vertex, Vertex, Vertex) {
-	// centre wall
-	m[e.y][e.x] = Wall
-	// up wall
-	m[e.y-1][e.x] = Wall
-	// down wall
-	m[e.y+1][e.x] = Wall
-	// left wall
-	m[e.y][e.x-1] = Wall
-	// right wall
-	m[e.y][e.x+1] = Wall
+func updateMap(e Vertex, m *grid.Dense[rune]) (Vertex, Vertex, Vertex, Vertex) {
+	// centre wall and the up, down, left and right walls
+	m.Set(e, Wall)
+	for _, n := range e.Neighbors4() {
+		m.Set(n, Wall)
+	}
 	// add the 4 entrances
-	// left-up
-	m[e.y-1][e.x-1] = Entrance
-	// right-up
-	m[e.y-1][e.x+1] = Entrance
-	// left-down
-	m[e.y+1][e.x-1] = Entrance
-	// right-down
-	m[e.y+1][e.x+1] = Entrance
-
-	return Vertex{e.y - 1, e.x - 1}, Vertex{e.y - 1, e.x + 1}, Vertex{e.y + 1, e.x - 1}, Vertex{e.y + 1, e.x + 1}
+	leftUp := e.Move(grid.Up).Move(grid.Left)
+	rightUp := e.Move(grid.Up).Move(grid.Right)
+	leftDown := e.Move(grid.Down).Move(grid.Left)
+	rightDown := e.Move(grid.Down).Move(grid.Right)
+	for _, entrance := range []Vertex{leftUp, rightUp, leftDown, rightDown} {
+		m.Set(entrance, Entrance)
+	}
+
+	return leftUp, rightUp, leftDown, rightDown
 }
 
-func part1(m [][]rune) int {
+func Part1(m *grid.Dense[rune]) int {
 	entrance := findEntrance(m)
 	keys := findKeys(m)
 	sourcePoints, remainingKeysVi, remainingKeysIv, remainingKeysBitSet := robotData([]Vertex{entrance}, keys, nil)
@@ -428,7 +349,7 @@ func part1(m [][]rune) int {
 	return minDistance1Robot(m, distances, entrance, remainingKeysVi, remainingKeysIv, remainingKeysBitSet, visitedKeys, cache)
 }
 
-func part2(m [][]rune) int {
+func Part2(m *grid.Dense[rune]) int {
 	entrance := findEntrance(m)
 	e1, e2, e3, e4 := updateMap(entrance, m)
 	keys := findKeys(m)
@@ -442,36 +363,22 @@ func part2(m [][]rune) int {
 	return minDistance4Robots(m, distances, entrance, remainingKeysVi, remainingKeysIv, remainingKeysBitSet, visitedKeys, cache, robotsPos)
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	m, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
-	}
-
-	m := make([][]rune, 0)
-	for _, line := range inputs {
-		r := make([]rune, 0)
-		for _, s := range line {
-			r = append(r, s)
-		}
-		m = append(m, r)
+		return nil, err
 	}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
-	}
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(m))
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(m) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(m) }),
+	}, nil
+}
 
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(m))
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (*grid.Dense[rune], error) {
+	return parse.File(path, parse.Grid(""))
 }
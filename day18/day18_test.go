@@ -0,0 +1,213 @@
+package day18
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+func readExample(t *testing.T, lines ...string) *grid.Dense[rune] {
+	m, err := parse.Grid("")("example", strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+func TestPart1Examples(t *testing.T) {
+	tests := []struct {
+		vault []string
+		steps int
+	}{
+		{[]string{
+			"#########",
+			"#b.A.@.a#",
+			"#########",
+		}, 8},
+		{[]string{
+			"########################",
+			"#f.D.E.e.C.b.A.@.a.B.c.#",
+			"######################.#",
+			"#d.....................#",
+			"########################",
+		}, 86},
+		{[]string{
+			"########################",
+			"#...............b.C.D.f#",
+			"#.######################",
+			"#.....@.a.B.c.d.A.e.F.g#",
+			"########################",
+		}, 132},
+		{[]string{
+			"#################",
+			"#i.G..c...e..H.p#",
+			"########.########",
+			"#j.A..b...f..D.o#",
+			"########@########",
+			"#k.E..a...g..B.n#",
+			"########.########",
+			"#l.F..d...h..C.m#",
+			"#################",
+		}, 136},
+		{[]string{
+			"########################",
+			"#@..............ac.GI.b#",
+			"###d#e#f################",
+			"###A#B#C################",
+			"###g#h#i################",
+			"########################",
+		}, 81},
+	}
+	for i, test := range tests {
+		if steps := Part1(readExample(t, test.vault...)); steps != test.steps {
+			t.Errorf("example %d: expected %d steps got %d", i+1, test.steps, steps)
+		}
+	}
+}
+
+// the examples of part 2 with the middle of the vault before it is split
+// between the four robots
+func TestPart2Examples(t *testing.T) {
+	tests := []struct {
+		vault []string
+		steps int
+	}{
+		{[]string{
+			"#######",
+			"#a.#Cd#",
+			"##...##",
+			"##.@.##",
+			"##...##",
+			"#cB#Ab#",
+			"#######",
+		}, 8},
+		{[]string{
+			"###############",
+			"#d.ABC.#.....a#",
+			"######...######",
+			"######.@.######",
+			"######...######",
+			"#b.....#.....c#",
+			"###############",
+		}, 24},
+		{[]string{
+			"#############",
+			"#DcBa.#.GhKl#",
+			"#.###...#I###",
+			"#e#d#.@.#j#k#",
+			"###C#...###J#",
+			"#fEbA.#.FgHi#",
+			"#############",
+		}, 32},
+		{[]string{
+			"#############",
+			"#g#f.D#..h#l#",
+			"#F###e#E###.#",
+			"#dCba...BcIJ#",
+			"#####.@.#####",
+			"#nK.L...G...#",
+			"#M###N#H###.#",
+			"#o#m..#i#jk.#",
+			"#############",
+		}, 72},
+	}
+	for i, test := range tests {
+		if steps := Part2(readExample(t, test.vault...)); steps != test.steps {
+			t.Errorf("example %d: expected %d steps got %d", i+1, test.steps, steps)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		m, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part1(m)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		m, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part2(m)
+	}
+}
+
+// the distance from the entrance to a key, against the quadratic search it
+// replaced
+
+func BenchmarkDistance(b *testing.B) {
+	m, err := ReadInput("input/part1.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	keys := findKeys(m)
+	entrance := findEntrance(m)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dijkstra(m, entrance, keys['a'], keys)
+	}
+}
+
+func BenchmarkDistanceQuadratic(b *testing.B) {
+	m, err := ReadInput("input/part1.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	keys := findKeys(m)
+	entrance := findEntrance(m)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		quadraticDistance(m, entrance, keys['a'])
+	}
+}
+
+// the Dijkstra search scanning the distances of every cell for the closest
+// one still queued
+func quadraticDistance(m *grid.Dense[rune], source Vertex, destination Vertex) int {
+	dist := make(map[Vertex]int)
+	m.Each(func(p Vertex, _ rune) {
+		dist[p] = math.MaxInt
+	})
+	dist[source] = 0
+	queue := []Vertex{source}
+	visitedKeys := make(map[rune]bool)
+	for len(queue) > 0 {
+		u, index := Vertex{}, 0
+		min := math.MaxInt
+		for p, d := range dist {
+			for i, q := range queue {
+				if q == p && d <= min {
+					u, index, min = p, i, d
+					break
+				}
+			}
+		}
+		if u == destination {
+			return dist[u]
+		}
+		queue = append(queue[:index], queue[index+1:]...)
+
+		for _, v := range findNeighbors(u, m, visitedKeys, true) {
+			if dist[u]+1 < dist[v] {
+				dist[v] = dist[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	return -1
+}
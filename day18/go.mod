@@ -1,12 +0,0 @@
-module github.com/stanciua/adventofcode2019
-
-go 1.18
-
-require github.com/k0kubun/pp/v3 v3.1.0
-
-require (
-	github.com/mattn/go-colorable v0.1.12 // indirect
-	github.com/mattn/go-isatty v0.0.14 // indirect
-	golang.org/x/sys v0.0.0-20210927094055-39ccf1dd6fa6 // indirect
-	golang.org/x/text v0.3.7 // indirect
-)
@@ -1,12 +1,10 @@
-package main
+package day19
 
 import (
-	"bufio"
 	"fmt"
-	"log"
-	"os"
-	"strconv"
-	"strings"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
 )
 
 const (
@@ -339,7 +337,7 @@ func countPoints(view [][]rune) int {
 	return count
 }
 
-func part1(input []int64) int {
+func Part1(input []int64) int {
 	d := Drone{vm: NewVM()}
 	d.vm.loadProgram(input)
 	changes := d.memoryChanges(input)
@@ -347,7 +345,7 @@ func part1(input []int64) int {
 	return countPoints(view)
 }
 
-func part2(input []int64) int {
+func Part2(input []int64) int {
 	d := Drone{vm: NewVM()}
 	d.vm.loadProgram(input)
 	changes := d.memoryChanges(input)
@@ -383,41 +381,22 @@ func (robot *Drone) deployDrone(input []int64) int64 {
 	return output
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	program, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
-	}
-
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	// we have just one line, with elements separated by commas
-	if len(inputs) != 1 {
-		panic("The input should be only one line long!")
-	}
-
-	var program []int64
-	for _, integer := range strings.Split(inputs[0], ",") {
-		if val, err := strconv.ParseInt(integer, 10, 64); err != nil {
-			panic(err)
-		} else {
-			program = append(program, val)
-		}
-	}
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(program))
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(program) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(program) }),
+	}, nil
+}
 
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(program))
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]int64, error) {
+	return parse.File(path, parse.Intcode)
 }
@@ -0,0 +1,26 @@
+package day19
+
+import (
+	"path/filepath"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+// Draw saves the tractor beam in the 50x50 area scanned for part 1 into dir
+func Draw(path, dir string, scale int) error {
+	input, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	d := Drone{vm: NewVM()}
+	d.vm.loadProgram(input)
+	changes := d.memoryChanges(input)
+	view, _, _, _ := d.buildBeam(input, changes, 50, 50)
+	rows := make([]string, len(view))
+	for i, row := range view {
+		rows[i] = string(row)
+	}
+
+	return imaging.SavePNG(filepath.Join(dir, "day19-beam.png"), rows, imaging.Mono, scale)
+}
@@ -0,0 +1,90 @@
+package day2
+
+import (
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+const terminationResult int = 19690720
+
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	program, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	programCopy := append(program[:0:0], program...)
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(programCopy) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(program) }),
+	}, nil
+}
+
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]int, error) {
+	program, err := parse.File(path, parse.Intcode)
+	if err != nil {
+		return nil, err
+	}
+
+	ints := make([]int, len(program))
+	for i, v := range program {
+		ints[i] = int(v)
+	}
+
+	return ints, nil
+}
+
+// Part1 returns the value left at address 0 by the program run with noun 12
+// and verb 2
+func Part1(input []int) int {
+	// restore the "1202 program alarm"
+	return executeProgramWithInputs(input, 12, 2)
+}
+
+// Part2 returns 100 * noun + verb for the noun and verb making the program
+// output 19690720, -1 if there are none
+func Part2(input []int) int {
+	search := Search{
+		Program: input,
+		// the noun and the verb
+		Variables: []Variable{{Address: 1, Min: 0, Max: 99}, {Address: 2, Min: 0, Max: 99}},
+		Target:    terminationResult,
+	}
+	if values, ok, err := search.Solve(); err == nil && ok {
+		return 100*values[0] + values[1]
+	}
+	return -1
+}
+
+func executeProgramWithInputs(input []int, noun int, verb int) int {
+	input[1] = noun
+	input[2] = verb
+	program := input[:]
+	for program[0] != 99 {
+		opcodeData := program[:4]
+		executeOpcode(opcodeData, input)
+		program = program[4:]
+	}
+	return input[0]
+}
+
+func executeOpcode(opcodeData []int, program []int) {
+	opcode := opcodeData[0]
+	input1 := opcodeData[1]
+	input2 := opcodeData[2]
+	output := opcodeData[3]
+	switch opcode {
+	case 1:
+		// add the input and store it inside the output
+		program[output] = program[input1] + program[input2]
+	case 2:
+		// multiply the input and store it inside the output
+		program[output] = program[input1] * program[input2]
+	default:
+		panic("Unknown opcode encountered!")
+	}
+}
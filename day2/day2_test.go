@@ -0,0 +1,168 @@
+package day2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	program, err := ReadInput("input/part1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	search := Search{
+		Program:   program,
+		Variables: []Variable{{Address: 1, Min: 0, Max: 99}, {Address: 2, Min: 0, Max: 99}},
+		Target:    terminationResult,
+	}
+
+	l, ok, err := search.Linear()
+	if err != nil || !ok || l.Coefficients[1] != 1 {
+		t.Errorf("expected the output to grow by 1 with the verb, got %v, %t, %v", l, ok, err)
+	}
+	found, ok, err := search.Find()
+	if err != nil || !ok || !reflect.DeepEqual(found, []int{64, 72}) {
+		t.Errorf("expected noun 64 and verb 72, got %v", found)
+	}
+	solved, ok, err := search.Solve()
+	if err != nil || !ok || !reflect.DeepEqual(solved, found) {
+		t.Errorf("expected the linear solution to match %v, got %v", found, solved)
+	}
+}
+
+func TestSymbolic(t *testing.T) {
+	program, err := ReadInput("input/part1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs, err := Symbolic(program, []int{1, 2}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := outputs[0]
+	if p.Degree() != 1 || p.Eval([]int{12, 2}) != 3716250 || p.Eval([]int{64, 72}) != terminationResult {
+		t.Errorf("unexpected expression %s", p.Format([]string{"noun", "verb"}))
+	}
+
+	program = []int{
+		1, 13, 14, 15, // m[15] = m[13] + m[14]
+		2, 15, 13, 0, // m[0] = m[15] * m[13]
+		1, 0, 16, 0, // m[0] += m[16]
+		99, 0, 0, 0, -3,
+	}
+	outputs, err = Symbolic(program, []int{13, 14}, 0, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := outputs[0].Format([]string{"a", "b"}); s != "a*a + a*b - 3" {
+		t.Errorf("expected a*a + a*b - 3, got %s", s)
+	}
+	if s := outputs[1].String(); s != "x0 + x1" {
+		t.Errorf("expected x0 + x1, got %s", s)
+	}
+	if _, ok := outputs[0].Linear(2); ok {
+		t.Errorf("expected a polynomial of degree 2 not to be linear")
+	}
+
+	// a cell read through a variable is unknown
+	if _, err := Symbolic([]int{1, 0, 6, 0, 99, 0, 0}, []int{1}, 0); err == nil {
+		t.Errorf("expected an error for an unknown output cell")
+	}
+	// and so is writing through a variable
+	if _, err := Symbolic([]int{1, 5, 5, 0, 99, 0, 0}, []int{3}, 0); err == nil {
+		t.Errorf("expected an error for an output address depending on the variables")
+	}
+	// variables must be distinct cells of the program
+	if _, err := Symbolic([]int{99}, []int{5}, 0); err == nil {
+		t.Errorf("expected an error for a variable out of memory")
+	}
+	if _, err := Symbolic([]int{1, 0, 0, 0, 99}, []int{1, 1}, 0); err == nil {
+		t.Errorf("expected an error for two variables at the same address")
+	}
+}
+
+func TestSearchNonLinear(t *testing.T) {
+	// multiply the values at addresses 9 and 10 into address 0
+	search := Search{
+		Program:   []int{2, 9, 10, 0, 99, 0, 0, 0, 0, 0, 0},
+		Variables: []Variable{{Address: 9, Min: 0, Max: 5}, {Address: 10, Min: 0, Max: 5}},
+		Target:    12,
+		Workers:   3,
+	}
+	if _, ok, _ := search.Linear(); ok {
+		t.Errorf("expected a product not to be linear")
+	}
+	if values, ok, err := search.Solve(); err != nil || !ok || !reflect.DeepEqual(values, []int{3, 4}) {
+		t.Errorf("expected 3 and 4, got %v", values)
+	}
+
+	// adding instead has a solution only in the linear form
+	search.Program[0] = 1
+	search.Target = 7
+	if values, ok, err := search.Solve(); err != nil || !ok || !reflect.DeepEqual(values, []int{2, 5}) {
+		t.Errorf("expected 2 and 5, got %v", values)
+	}
+	search.Target = 11
+	if values, ok, err := search.Solve(); err != nil || ok {
+		t.Errorf("expected no solution, got %v", values)
+	}
+}
+
+func TestSearchSkipsFailures(t *testing.T) {
+	// the variable is the address read, out of memory for most values
+	search := Search{
+		Program:   []int{1, 0, 5, 0, 99, 40},
+		Variables: []Variable{{Address: 1, Min: 0, Max: 1000}},
+		Target:    139,
+	}
+	if values, ok, err := search.Find(); err != nil || !ok || !reflect.DeepEqual(values, []int{4}) {
+		t.Errorf("expected 4, got %v", values)
+	}
+}
+
+func TestSearchInvalidVariables(t *testing.T) {
+	program := []int{1, 0, 0, 0, 99}
+	for _, variables := range [][]Variable{
+		{{Address: 10, Min: 0, Max: 1}},
+		{{Address: -1, Min: 0, Max: 1}},
+		{{Address: 1, Min: 0, Max: 1}, {Address: 1, Min: 0, Max: 1}},
+		{{Address: 1, Min: 5, Max: 1}},
+		{{Address: 1, Min: 0, Max: 1 << 40}, {Address: 2, Min: 0, Max: 1 << 40}},
+		{{Address: 1, Min: -1 << 62, Max: 1 << 62}},
+	} {
+		search := Search{Program: program, Variables: variables, Target: 2}
+		if _, _, err := search.Find(); err == nil {
+			t.Errorf("expected Find to refuse %v", variables)
+		}
+		if _, _, err := search.Linear(); err == nil {
+			t.Errorf("expected Linear to refuse %v", variables)
+		}
+		if _, _, err := search.Solve(); err == nil {
+			t.Errorf("expected Solve to refuse %v", variables)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		program, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part1(program)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		program, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part2(program)
+	}
+}
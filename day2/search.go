@@ -0,0 +1,331 @@
+package day2
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Variable is a memory address set to every value from Min to Max before
+// running the program
+type Variable struct {
+	Address int
+	Min     int
+	Max     int
+}
+
+// Search looks for the values of the variables that make the program halt
+// with Target at address 0
+type Search struct {
+	Program   []int
+	Variables []Variable
+	Target    int
+	// the number of goroutines running the program, all the CPUs when 0
+	Workers int
+}
+
+// the number of combinations of values tried by a worker at once
+const chunkSize = 256
+
+// run the program until it halts, returning the value left at address 0
+func run(memory []int) (int, error) {
+	ip := 0
+	for {
+		if ip >= len(memory) {
+			return 0, fmt.Errorf("instruction pointer %d out of memory", ip)
+		}
+		if memory[ip] == 99 {
+			return memory[0], nil
+		}
+		if ip+3 >= len(memory) {
+			return 0, fmt.Errorf("instruction at %d cut short", ip)
+		}
+		opcodeData := memory[ip : ip+4]
+		if opcodeData[0] != 1 && opcodeData[0] != 2 {
+			return 0, fmt.Errorf("unknown opcode %d at %d", opcodeData[0], ip)
+		}
+		for _, address := range opcodeData[1:] {
+			if address < 0 || address >= len(memory) {
+				return 0, fmt.Errorf("address %d out of memory at %d", address, ip)
+			}
+		}
+		executeOpcode(opcodeData, memory)
+		ip += 4
+	}
+}
+
+// the most combinations of values a search takes, far more than can ever be
+// tried and far from overflowing the chunk indexes
+const maxCombinations = 1 << 48
+
+// validate checks that the variables are distinct addresses of the program
+// with a value to try, and not too many values to try together
+func (s Search) validate() error {
+	seen := make(map[int]bool, len(s.Variables))
+	n := 1
+	for i, v := range s.Variables {
+		if v.Address < 0 || v.Address >= len(s.Program) {
+			return fmt.Errorf("variable %d: address %d out of memory", i, v.Address)
+		}
+		if seen[v.Address] {
+			return fmt.Errorf("variable %d: address %d is already a variable", i, v.Address)
+		}
+		seen[v.Address] = true
+		if v.Min > v.Max {
+			return fmt.Errorf("variable %d: no values from %d to %d", i, v.Min, v.Max)
+		}
+		// a negative size is an overflow
+		size := v.Max - v.Min + 1
+		if size <= 0 || size > maxCombinations/n {
+			return fmt.Errorf("more than %d combinations of values", maxCombinations)
+		}
+		n *= size
+	}
+
+	return nil
+}
+
+// the number of combinations of values of the variables
+func (s Search) combinations() int {
+	n := 1
+	for _, v := range s.Variables {
+		if v.Max < v.Min {
+			return 0
+		}
+		n *= v.Max - v.Min + 1
+	}
+
+	return n
+}
+
+// the values of the combination with the given index, the last variable
+// changing the fastest
+func (s Search) values(index int, values []int) {
+	for i := len(s.Variables) - 1; i >= 0; i-- {
+		v := s.Variables[i]
+		size := v.Max - v.Min + 1
+		values[i] = v.Min + index%size
+		index /= size
+	}
+}
+
+// run the program with the given values of the variables in memory, which
+// is overwritten
+func (s Search) output(memory []int, values []int) (int, error) {
+	copy(memory, s.Program)
+	for i, v := range s.Variables {
+		memory[v.Address] = values[i]
+	}
+
+	return run(memory)
+}
+
+// Find tries every combination of values of the variables in parallel,
+// returning the first solution in the order of the variables; combinations
+// for which the program fails are skipped. Invalid variables are an error
+func (s Search) Find() ([]int, bool, error) {
+	if err := s.validate(); err != nil {
+		return nil, false, err
+	}
+	workers := s.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	total := int64(s.combinations())
+
+	// the chunks are handed out in order and the lowest solution is kept, so
+	// the result does not depend on the scheduling
+	var next int64
+	best := total
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			memory := make([]int, len(s.Program))
+			values := make([]int, len(s.Variables))
+			for {
+				start := (atomic.AddInt64(&next, 1) - 1) * chunkSize
+				if start >= atomic.LoadInt64(&best) {
+					return
+				}
+				for i := start; i < start+chunkSize && i < atomic.LoadInt64(&best); i++ {
+					s.values(int(i), values)
+					if out, err := s.output(memory, values); err != nil || out != s.Target {
+						continue
+					}
+					for found := atomic.LoadInt64(&best); i < found; found = atomic.LoadInt64(&best) {
+						if atomic.CompareAndSwapInt64(&best, found, i) {
+							break
+						}
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if best == total {
+		return nil, false, nil
+	}
+	values := make([]int, len(s.Variables))
+	s.values(int(best), values)
+
+	return values, true, nil
+}
+
+// Linear is the output of a program written as Constant plus the sum of the
+// variables, each multiplied by its coefficient
+type Linear struct {
+	Constant     int
+	Coefficients []int
+}
+
+// Eval returns the output for the given values of the variables
+func (l Linear) Eval(values []int) int {
+	out := l.Constant
+	for i, c := range l.Coefficients {
+		out += c * values[i]
+	}
+
+	return out
+}
+
+// Linear probes the program to tell whether its output is a linear function
+// of the variables: the coefficients are measured one variable at a time,
+// then checked against the corners of the ranges and with pairs of variables
+// changed together, which exposes products of variables. Invalid variables
+// are an error
+func (s Search) Linear() (Linear, bool, error) {
+	if err := s.validate(); err != nil {
+		return Linear{}, false, err
+	}
+	memory := make([]int, len(s.Program))
+	values := make([]int, len(s.Variables))
+	for i, v := range s.Variables {
+		values[i] = v.Min
+	}
+	base, err := s.output(memory, values)
+	if err != nil {
+		return Linear{}, false, nil
+	}
+
+	l := Linear{Coefficients: make([]int, len(s.Variables))}
+	for i, v := range s.Variables {
+		if v.Max == v.Min {
+			continue
+		}
+		values[i]++
+		out, err := s.output(memory, values)
+		values[i]--
+		if err != nil {
+			return Linear{}, false, nil
+		}
+		l.Coefficients[i] = out - base
+	}
+	// the variables are all at their minimum and the constant still 0
+	l.Constant = base - l.Eval(values)
+
+	var probes [][]int
+	lowest := append([]int(nil), values...)
+	highest := make([]int, len(s.Variables))
+	for i, v := range s.Variables {
+		highest[i] = v.Max
+	}
+	probes = append(probes, highest)
+	for i := range s.Variables {
+		for j := i + 1; j < len(s.Variables); j++ {
+			if s.Variables[i].Max == s.Variables[i].Min || s.Variables[j].Max == s.Variables[j].Min {
+				continue
+			}
+			pair := append([]int(nil), lowest...)
+			pair[i]++
+			pair[j]++
+			probes = append(probes, pair)
+			pair = append([]int(nil), highest...)
+			pair[i]--
+			pair[j]--
+			probes = append(probes, pair)
+		}
+	}
+	for _, probe := range probes {
+		if out, err := s.output(memory, probe); err != nil || out != l.Eval(probe) {
+			return Linear{}, false, nil
+		}
+	}
+
+	return l, true, nil
+}
+
+// Expression returns the output of the program as a polynomial of the
+// variables, see Symbolic
+func (s Search) Expression() (Polynomial, error) {
+	addresses := make([]int, len(s.Variables))
+	for i, v := range s.Variables {
+		addresses[i] = v.Address
+	}
+	outputs, err := Symbolic(s.Program, addresses, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return outputs[0], nil
+}
+
+// Solve finds the first solution in the order of the variables like Find,
+// but solves the equation directly when the output is a linear function of
+// the variables, known from its expression or else by probing the program;
+// the solution is checked by running the program. Invalid variables are an
+// error
+func (s Search) Solve() ([]int, bool, error) {
+	if err := s.validate(); err != nil {
+		return nil, false, err
+	}
+	var l Linear
+	var ok bool
+	if p, err := s.Expression(); err == nil {
+		l, ok = p.Linear(len(s.Variables))
+	} else {
+		// the variables are valid, so probing cannot fail
+		l, ok, _ = s.Linear()
+	}
+	if !ok {
+		return s.Find()
+	}
+
+	values := make([]int, len(s.Variables))
+	if len(values) == 0 || !s.solveLinear(l, values, 0, s.Target-l.Constant) {
+		return s.Find()
+	}
+	if out, err := s.output(make([]int, len(s.Program)), values); err != nil || out != s.Target {
+		return s.Find()
+	}
+
+	return values, true, nil
+}
+
+// set the variables from i on so that the sum of their terms is rest, trying
+// the values in order and computing the last variable instead of trying it
+func (s Search) solveLinear(l Linear, values []int, i int, rest int) bool {
+	v, c := s.Variables[i], l.Coefficients[i]
+	if i == len(values)-1 {
+		if c == 0 {
+			values[i] = v.Min
+			return rest == 0 && v.Min <= v.Max
+		}
+		if rest%c != 0 || rest/c < v.Min || rest/c > v.Max {
+			return false
+		}
+		values[i] = rest / c
+		return true
+	}
+
+	for values[i] = v.Min; values[i] <= v.Max; values[i]++ {
+		if s.solveLinear(l, values, i+1, rest-c*values[i]) {
+			return true
+		}
+	}
+
+	return false
+}
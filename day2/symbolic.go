@@ -0,0 +1,312 @@
+package day2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// monomial is a product of variables, the indexes of the variables as bytes
+// in ascending order, repeated for powers; the empty monomial is 1
+type monomial string
+
+func (m monomial) times(o monomial) monomial {
+	b := []byte(string(m) + string(o))
+	sort.Slice(b, func(i, j int) bool { return b[i] < b[j] })
+	return monomial(b)
+}
+
+// Polynomial is a sum of products of variables with integer coefficients, the
+// coefficients are never 0
+type Polynomial map[monomial]int
+
+// Constant returns the polynomial of the given value
+func Constant(c int) Polynomial {
+	p := Polynomial{}
+	if c != 0 {
+		p[""] = c
+	}
+
+	return p
+}
+
+// Var returns the polynomial of the variable with the given index
+func Var(i int) Polynomial {
+	return Polynomial{monomial([]byte{byte(i)}): 1}
+}
+
+func (p Polynomial) add(m monomial, c int) {
+	if p[m] += c; p[m] == 0 {
+		delete(p, m)
+	}
+}
+
+// Add returns p + q
+func (p Polynomial) Add(q Polynomial) Polynomial {
+	sum := Polynomial{}
+	for m, c := range p {
+		sum.add(m, c)
+	}
+	for m, c := range q {
+		sum.add(m, c)
+	}
+
+	return sum
+}
+
+// Mul returns p * q
+func (p Polynomial) Mul(q Polynomial) Polynomial {
+	product := Polynomial{}
+	for m1, c1 := range p {
+		for m2, c2 := range q {
+			product.add(m1.times(m2), c1*c2)
+		}
+	}
+
+	return product
+}
+
+// Value returns the value of a polynomial without variables
+func (p Polynomial) Value() (int, bool) {
+	if p.Degree() > 0 {
+		return 0, false
+	}
+
+	return p[""], true
+}
+
+// Degree returns the highest number of variables multiplied in a term
+func (p Polynomial) Degree() int {
+	degree := 0
+	for m := range p {
+		if len(m) > degree {
+			degree = len(m)
+		}
+	}
+
+	return degree
+}
+
+// Eval returns the value of the polynomial for the given values of the
+// variables
+func (p Polynomial) Eval(values []int) int {
+	sum := 0
+	for m, c := range p {
+		for _, i := range []byte(m) {
+			c *= values[i]
+		}
+		sum += c
+	}
+
+	return sum
+}
+
+// Linear returns the polynomial of degree at most 1 as a linear function of n
+// variables
+func (p Polynomial) Linear(n int) (Linear, bool) {
+	if p.Degree() > 1 {
+		return Linear{}, false
+	}
+	l := Linear{Constant: p[""], Coefficients: make([]int, n)}
+	for m, c := range p {
+		if len(m) == 1 {
+			if int(m[0]) >= n {
+				return Linear{}, false
+			}
+			l.Coefficients[m[0]] = c
+		}
+	}
+
+	return l, true
+}
+
+// Format writes the polynomial with the given names of the variables, the
+// terms of highest degree first
+func (p Polynomial) Format(names []string) string {
+	if len(p) == 0 {
+		return "0"
+	}
+	monomials := make([]monomial, 0, len(p))
+	for m := range p {
+		monomials = append(monomials, m)
+	}
+	sort.Slice(monomials, func(i, j int) bool {
+		if len(monomials[i]) != len(monomials[j]) {
+			return len(monomials[i]) > len(monomials[j])
+		}
+		return monomials[i] < monomials[j]
+	})
+
+	var b strings.Builder
+	for i, m := range monomials {
+		c := p[m]
+		switch {
+		case i > 0 && c < 0:
+			b.WriteString(" - ")
+			c = -c
+		case i > 0:
+			b.WriteString(" + ")
+		case c < 0:
+			b.WriteString("-")
+			c = -c
+		}
+
+		factors := make([]string, 0, len(m)+1)
+		if c != 1 || len(m) == 0 {
+			factors = append(factors, fmt.Sprint(c))
+		}
+		for _, v := range []byte(m) {
+			factors = append(factors, names[v])
+		}
+		b.WriteString(strings.Join(factors, "*"))
+	}
+
+	return b.String()
+}
+
+// String writes the polynomial naming the variables x0, x1 and so on
+func (p Polynomial) String() string {
+	names := make([]string, 256)
+	for i := range names {
+		names[i] = fmt.Sprintf("x%d", i)
+	}
+
+	return p.Format(names)
+}
+
+// cell is a memory cell of a symbolic run
+type cell struct {
+	// nil for the cells holding the constant c, which are most of them
+	p       Polynomial
+	c       int
+	unknown bool
+}
+
+func (c cell) constant() (int, bool) {
+	if c.p == nil {
+		return c.c, true
+	}
+
+	return c.p.Value()
+}
+
+func (c cell) polynomial() Polynomial {
+	if c.p == nil {
+		return Constant(c.c)
+	}
+
+	return c.p
+}
+
+// Symbolic runs the program with the variables at the given addresses and
+// returns the values left in the output cells as polynomials of the
+// variables. The opcodes and the cells written must not depend on the
+// variables; cells read through an address depending on them are unknown,
+// which is only an error if they end up in an output cell, an opcode or an
+// address.
+func Symbolic(program []int, variables []int, outputs ...int) ([]Polynomial, error) {
+	if len(variables) > 256 {
+		return nil, fmt.Errorf("at most 256 variables are supported, got %d", len(variables))
+	}
+	memory := make([]cell, len(program))
+	for i, v := range program {
+		memory[i].c = v
+	}
+	for i, address := range variables {
+		if address < 0 || address >= len(memory) {
+			return nil, fmt.Errorf("variable %d: address %d out of memory", i, address)
+		}
+		if memory[address].p != nil {
+			return nil, fmt.Errorf("variable %d: address %d is already a variable", i, address)
+		}
+		memory[address].p = Var(i)
+	}
+
+	// the concrete value of the cell at address, read at ip
+	concrete := func(address, ip int) (int, error) {
+		if address < 0 || address >= len(memory) {
+			return 0, fmt.Errorf("address %d out of memory at %d", address, ip)
+		}
+		if memory[address].unknown {
+			return 0, fmt.Errorf("cell %d used at %d is unknown", address, ip)
+		}
+		v, ok := memory[address].constant()
+		if !ok {
+			return 0, fmt.Errorf("cell %d used at %d depends on the variables: %v", address, ip, memory[address].p)
+		}
+		return v, nil
+	}
+	// the cell pointed to by the cell at address, unknown when the pointer is
+	// unknown or depends on the variables
+	read := func(address, ip int) (cell, error) {
+		if memory[address].unknown {
+			return cell{unknown: true}, nil
+		}
+		pointer, ok := memory[address].constant()
+		if !ok {
+			return cell{unknown: true}, nil
+		}
+		if pointer < 0 || pointer >= len(memory) {
+			return cell{}, fmt.Errorf("address %d out of memory at %d", pointer, ip)
+		}
+		return memory[pointer], nil
+	}
+
+	for ip := 0; ; ip += 4 {
+		opcode, err := concrete(ip, ip)
+		if err != nil {
+			return nil, err
+		}
+		if opcode == 99 {
+			break
+		}
+		if opcode != 1 && opcode != 2 {
+			return nil, fmt.Errorf("unknown opcode %d at %d", opcode, ip)
+		}
+		if ip+3 >= len(memory) {
+			return nil, fmt.Errorf("instruction at %d cut short", ip)
+		}
+
+		input1, err := read(ip+1, ip)
+		if err != nil {
+			return nil, err
+		}
+		input2, err := read(ip+2, ip)
+		if err != nil {
+			return nil, err
+		}
+		output, err := concrete(ip+3, ip)
+		if err != nil {
+			return nil, err
+		}
+		if output < 0 || output >= len(memory) {
+			return nil, fmt.Errorf("address %d out of memory at %d", output, ip)
+		}
+
+		switch {
+		case input1.unknown || input2.unknown:
+			memory[output] = cell{unknown: true}
+		case input1.p == nil && input2.p == nil && opcode == 1:
+			memory[output] = cell{c: input1.c + input2.c}
+		case input1.p == nil && input2.p == nil:
+			memory[output] = cell{c: input1.c * input2.c}
+		case opcode == 1:
+			memory[output] = cell{p: input1.polynomial().Add(input2.polynomial())}
+		default:
+			memory[output] = cell{p: input1.polynomial().Mul(input2.polynomial())}
+		}
+	}
+
+	results := make([]Polynomial, len(outputs))
+	for i, address := range outputs {
+		if address < 0 || address >= len(memory) {
+			return nil, fmt.Errorf("output address %d out of memory", address)
+		}
+		if memory[address].unknown {
+			return nil, fmt.Errorf("output cell %d is unknown", address)
+		}
+		results[i] = memory[address].polynomial()
+	}
+
+	return results, nil
+}
@@ -0,0 +1,262 @@
+package day20
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/graph"
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+type Tile struct {
+	t     grid.Point
+	level int
+}
+
+type Portal struct {
+	e1       grid.Point
+	e2       grid.Point
+	distance int
+}
+
+const (
+	Wall        rune = '#'
+	OpenPassage rune = '.'
+	EmptySpace  rune = ' '
+)
+
+func findPortals(m *grid.Dense[rune]) map[string]Portal {
+	portals := make(map[string]Portal)
+	m.Each(func(t grid.Point, c rune) {
+		if c != OpenPassage {
+			return
+		}
+
+		// check each neighbor for upper case letter
+		name := ""
+		for _, d := range grid.Directions {
+			n1 := t.Move(d)
+			n2 := n1.Move(d)
+			if !unicode.IsUpper(m.At(n1)) || !unicode.IsUpper(m.At(n2)) {
+				continue
+			}
+			// the labels are read from top to bottom or from left to right
+			if d == grid.Up || d == grid.Left {
+				name = string([]rune{m.At(n2), m.At(n1)})
+			} else {
+				name = string([]rune{m.At(n1), m.At(n2)})
+			}
+			break
+		}
+		if name == "" {
+			return
+		}
+
+		t0 := grid.Point{}
+		if p, ok := portals[name]; ok {
+			if p.e1 == t0 {
+				portals[name] = Portal{t, p.e2, 0}
+			} else {
+				portals[name] = Portal{p.e1, t, 0}
+			}
+		} else {
+			portals[name] = Portal{grid.Point{}, t, 0}
+		}
+	})
+	return portals
+}
+
+func splitPortals(m *grid.Dense[rune], portals map[string]Portal) (map[grid.Point]string, map[grid.Point]string) {
+	outerPortals, innerPortals := make(map[grid.Point]string), make(map[grid.Point]string)
+	// the inner portals are inside the donut hole, away from the outer labels
+	inside := grid.Rect{Min: grid.Point{X: 3, Y: 3}, Max: grid.Point{X: m.Width() - 4, Y: m.Height() - 4}}
+	m.Each(func(t grid.Point, c rune) {
+		if c == OpenPassage && inside.Contains(t) {
+			for k, v := range portals {
+				if v.e1 == t || v.e2 == t {
+					innerPortals[t] = k
+				}
+			}
+		}
+	})
+
+	for k, v := range portals {
+		if _, ok := innerPortals[v.e1]; ok {
+			outerPortals[v.e2] = k
+		} else {
+			if k == "AA" || k == "ZZ" {
+				outerPortals[v.e2] = k
+			} else {
+				outerPortals[v.e1] = k
+			}
+		}
+	}
+
+	return innerPortals, outerPortals
+}
+
+func connections(portals map[string]Portal) map[grid.Point]grid.Point {
+	conns := make(map[grid.Point]grid.Point)
+	for k, c := range portals {
+		if k == "AA" || k == "ZZ" {
+			continue
+		}
+
+		conns[c.e1] = c.e2
+		conns[c.e2] = c.e1
+	}
+
+	return conns
+}
+
+func dijkstra(m *grid.Dense[rune], source grid.Point, destination grid.Point, conns map[grid.Point]grid.Point) int {
+	neighbors := func(u grid.Point) []graph.Edge[grid.Point] {
+		step := 1
+		if _, ok := conns[u]; ok {
+			step++
+		}
+		edges := make([]graph.Edge[grid.Point], 0, 4)
+		for _, v := range findNeighbors(u, m, conns, true) {
+			edges = append(edges, graph.Edge[grid.Point]{To: v, Cost: step})
+		}
+		return edges
+	}
+
+	paths := graph.Dijkstra(source, neighbors, func(u grid.Point) bool { return u == destination })
+	if d, ok := paths.Dist(destination); ok {
+		return d
+	}
+	return -1
+}
+
+// the open passages next to a tile, when teleport is set stepping onto a portal
+// leads straight to its other end
+func findNeighbors(p grid.Point, m *grid.Dense[rune], conns map[grid.Point]grid.Point, teleport bool) []grid.Point {
+	neighbors := make([]grid.Point, 0)
+
+	for _, d := range grid.Directions {
+		t := p.Move(d)
+		if !m.In(t) || m.At(t) != OpenPassage {
+			continue
+		}
+		if c, ok := conns[t]; ok && teleport {
+			// if this is a portal add the other connection
+			neighbors = append(neighbors, c)
+		} else {
+			neighbors = append(neighbors, t)
+		}
+	}
+	return neighbors
+}
+
+// find the walking distances between the ends of the portals on the same level
+func portalDistances(m *grid.Dense[rune], conns map[grid.Point]grid.Point, ends []grid.Point) map[grid.Point][]graph.Edge[grid.Point] {
+	walk := func(p grid.Point) []grid.Point {
+		return findNeighbors(p, m, conns, false)
+	}
+
+	distances := make(map[grid.Point][]graph.Edge[grid.Point])
+	for _, from := range ends {
+		paths := graph.BFS(from, walk, nil)
+		for _, to := range ends {
+			if d, ok := paths.Dist(to); ok && to != from {
+				distances[from] = append(distances[from], graph.Edge[grid.Point]{To: to, Cost: d})
+			}
+		}
+	}
+
+	return distances
+}
+
+// find the shortest path through the recursive maze, walking between the ends
+// of the portals; going through a portal takes one more step and changes the
+// level, the outer portals being walls on the outermost level
+func shortestRecursivePath(source grid.Point, m *grid.Dense[rune], innerPortals map[grid.Point]string, outerPortals map[grid.Point]string, conns map[grid.Point]grid.Point) int {
+	ends := make([]grid.Point, 0, len(innerPortals)+len(outerPortals))
+	for p := range innerPortals {
+		ends = append(ends, p)
+	}
+	for p := range outerPortals {
+		ends = append(ends, p)
+	}
+	distances := portalDistances(m, conns, ends)
+
+	neighbors := func(v Tile) []graph.Edge[Tile] {
+		edges := make([]graph.Edge[Tile], 0, len(distances[v.t])+1)
+		for _, e := range distances[v.t] {
+			edges = append(edges, graph.Edge[Tile]{To: Tile{e.To, v.level}, Cost: e.Cost})
+		}
+		if _, ok := innerPortals[v.t]; ok {
+			edges = append(edges, graph.Edge[Tile]{To: Tile{conns[v.t], v.level + 1}, Cost: 1})
+		} else if p, ok := outerPortals[v.t]; ok && p != "AA" && p != "ZZ" && v.level > 0 {
+			edges = append(edges, graph.Edge[Tile]{To: Tile{conns[v.t], v.level - 1}, Cost: 1})
+		}
+		return edges
+	}
+
+	done := func(v Tile) bool {
+		return v.level == 0 && outerPortals[v.t] == "ZZ"
+	}
+	paths := graph.Dijkstra(Tile{source, 0}, neighbors, done)
+	if exit, ok := paths.Found(); ok {
+		d, _ := paths.Dist(exit)
+		return d
+	}
+
+	return -1
+}
+
+func Part1(m *grid.Dense[rune]) int {
+	portals := findPortals(m)
+	conns := connections(portals)
+	source := portals["AA"].e2
+	destination := portals["ZZ"].e2
+	return dijkstra(m, source, destination, conns)
+}
+
+func Part2(m *grid.Dense[rune]) int {
+	portals := findPortals(m)
+	innerPortals, outerPortals := splitPortals(m, portals)
+	conns := connections(portals)
+	source := portals["AA"].e2
+	return shortestRecursivePath(source, m, innerPortals, outerPortals, conns)
+}
+
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	m, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(m) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(m) }),
+	}, nil
+}
+
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (*grid.Dense[rune], error) {
+	lines, err := parse.File(path, parse.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	// editors might trim the empty space at the end of the lines
+	width := 0
+	for _, line := range lines {
+		if len(line.Text) > width {
+			width = len(line.Text)
+		}
+	}
+	inputs := make([]string, len(lines))
+	for i, line := range lines {
+		inputs[i] = line.Text + strings.Repeat(string(EmptySpace), width-len(line.Text))
+	}
+
+	return grid.ParseLines(inputs)
+}
@@ -0,0 +1,191 @@
+package day20
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+// the examples of the puzzle, without the empty space at the end of the lines
+const example1 = `         A
+         A
+  #######.#########
+  #######.........#
+  #######.#######.#
+  #######.#######.#
+  #######.#######.#
+  #####  B    ###.#
+BC...##  C    ###.#
+  ##.##       ###.#
+  ##...DE  F  ###.#
+  #####    G  ###.#
+  #########.#####.#
+DE..#######...###.#
+  #.#########.###.#
+FG..#########.....#
+  ###########.#####
+             Z
+             Z`
+
+const example3 = `             Z L X W       C
+             Z P Q B       K
+  ###########.#.#.#.#######.###############
+  #...#.......#.#.......#.#.......#.#.#...#
+  ###.#.#.#.#.#.#.#.###.#.#.#######.#.#.###
+  #.#...#.#.#...#.#.#...#...#...#.#.......#
+  #.###.#######.###.###.#.###.###.#.#######
+  #...#.......#.#...#...#.............#...#
+  #.#########.#######.#.#######.#######.###
+  #...#.#    F       R I       Z    #.#.#.#
+  #.###.#    D       E C       H    #.#.#.#
+  #.#...#                           #...#.#
+  #.###.#                           #.###.#
+  #.#....OA                       WB..#.#..ZH
+  #.###.#                           #.#.#.#
+CJ......#                           #.....#
+  #######                           #######
+  #.#....CK                         #......IC
+  #.###.#                           #.###.#
+  #.....#                           #...#.#
+  ###.###                           #.#.#.#
+XF....#.#                         RF..#.#.#
+  #####.#                           #######
+  #......CJ                       NM..#...#
+  ###.#.#                           #.###.#
+RE....#.#                           #......RF
+  ###.###        X   X       L      #.#.#.#
+  #.....#        F   Q       P      #.#.#.#
+  ###.###########.###.#######.#########.###
+  #.....#...#.....#.......#...#.....#.#...#
+  #####.#.###.#######.#######.###.###.#.#.#
+  #.......#.......#.#.#.#.#...#...#...#.#.#
+  #####.###.#####.#.#.#.#.###.###.#.###.###
+  #.......#.....#.#...#...............#...#
+  #############.#.#.###.###################
+               A O F   N
+               A A D   M`
+
+func readExample(t *testing.T, text string) *grid.Dense[rune] {
+	lines := strings.Split(text, "\n")
+	width := 0
+	for _, line := range lines {
+		if len(line) > width {
+			width = len(line)
+		}
+	}
+	for i, line := range lines {
+		lines[i] = line + strings.Repeat(string(EmptySpace), width-len(line))
+	}
+	m, err := grid.ParseLines(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+func TestExamples(t *testing.T) {
+	m := readExample(t, example1)
+	if steps := Part1(m); steps != 23 {
+		t.Errorf("Expected 23 steps got %d", steps)
+	}
+	if steps := Part2(m); steps != 26 {
+		t.Errorf("Expected 26 steps through the levels got %d", steps)
+	}
+	if steps := Part2(readExample(t, example3)); steps != 396 {
+		t.Errorf("Expected 396 steps through the levels got %d", steps)
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		m, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part1(m)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		m, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part2(m)
+	}
+}
+
+// the walk through the maze of part 1, against the quadratic search it
+// replaced
+
+func BenchmarkWalk(b *testing.B) {
+	m, err := ReadInput("input/part1.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Part1(m)
+	}
+}
+
+func BenchmarkWalkQuadratic(b *testing.B) {
+	m, err := ReadInput("input/part1.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	portals := findPortals(m)
+	conns := connections(portals)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		quadraticWalk(m, portals["AA"].e2, portals["ZZ"].e2, conns)
+	}
+}
+
+// the Dijkstra search scanning the distances of every tile for the closest
+// one still queued
+func quadraticWalk(m *grid.Dense[rune], source grid.Point, destination grid.Point, conns map[grid.Point]grid.Point) int {
+	dist := make(map[grid.Point]int)
+	m.Each(func(p grid.Point, _ rune) {
+		dist[p] = math.MaxInt
+	})
+	dist[source] = 0
+	queue := []grid.Point{source}
+	for len(queue) > 0 {
+		u, index := grid.Point{}, 0
+		min := math.MaxInt
+		for p, d := range dist {
+			for i, q := range queue {
+				if q == p && d <= min {
+					u, index, min = p, i, d
+					break
+				}
+			}
+		}
+		if u == destination {
+			return dist[u]
+		}
+		queue = append(queue[:index], queue[index+1:]...)
+
+		step := 1
+		if _, ok := conns[u]; ok {
+			step++
+		}
+		for _, v := range findNeighbors(u, m, conns, true) {
+			if dist[u]+step < dist[v] {
+				dist[v] = dist[u] + step
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,31 @@
+package day20
+
+import (
+	"image/color"
+	"path/filepath"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+// Draw saves the donut maze into dir, the portal labels drawn in their own
+// color
+func Draw(path, dir string, scale int) error {
+	m, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	palette := imaging.Palette{
+		Background: color.Black,
+		Colors: map[rune]color.Color{
+			Wall:        color.RGBA{0x80, 0x80, 0x80, 0xff},
+			OpenPassage: color.RGBA{0x20, 0x20, 0x30, 0xff},
+		},
+	}
+	for label := 'A'; label <= 'Z'; label++ {
+		palette.Colors[label] = color.RGBA{0xff, 0xd0, 0x40, 0xff}
+	}
+	maze := m.Render(func(sym rune) rune { return sym })
+
+	return imaging.SavePNG(filepath.Join(dir, "day20-maze.png"), maze, palette, scale)
+}
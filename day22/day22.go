@@ -1,15 +1,13 @@
-package main
+package day22
 
 // Part 2 solved thanks to this Modulo Arithmetic tutorial:
 // https://codeforces.com/blog/entry/72593
 
 import (
-	"bufio"
-	"fmt"
-	"log"
 	"math/big"
-	"os"
-	"strings"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
 )
 
 const (
@@ -56,7 +54,7 @@ func solveLinearCongruence(lcf Lcf, x *big.Int) int64 {
 	return lcf.a.Int64()
 }
 
-func part1(techniques []Technique) int64 {
+func Part1(techniques []Technique) int64 {
 	m := big.NewInt(DECK_SIZE_1)
 	lcfn := getLcf(techniques[0], m)
 
@@ -92,7 +90,7 @@ func solveLinearCongruenceInverted(lcf Lcf, x *big.Int) int64 {
 	return lcf.b.Int64()
 }
 
-func part2(techniques []Technique) int64 {
+func Part2(techniques []Technique) int64 {
 	m := big.NewInt(DECK_SIZE_2)
 	n := int64(NO_SHUFFLES)
 	lcfn := getLcf(techniques[0], m)
@@ -139,45 +137,39 @@ func pow_compose(lcf Lcf, k int64) Lcf {
 	return lcfg
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	techniques, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
-	}
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(techniques) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(techniques) }),
+	}, nil
+}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]Technique, error) {
+	shuffles, err := parse.File(path, parse.Techniques)
+	if err != nil {
+		return nil, err
 	}
 
-	techniques := make([]Technique, 0)
-	for _, line := range inputs {
-		var s string
-		n := 0
-		if strings.HasPrefix(line, "cut") {
-			fmt.Sscanf(line, "%s%d", &s, &n)
-			techniques = append(techniques, Technique{Cut, n})
-		} else if strings.HasPrefix(line, "deal with increment") {
-			fmt.Sscanf(line, "%s%s%s%d", &s, &s, &s, &n)
-			techniques = append(techniques, Technique{Increment, n})
-		} else if strings.HasPrefix(line, "deal into new stack") {
+	techniques := make([]Technique, 0, len(shuffles))
+	for _, s := range shuffles {
+		switch s.Shuffle {
+		case parse.Cut:
+			techniques = append(techniques, Technique{Cut, s.N})
+		case parse.Increment:
+			techniques = append(techniques, Technique{Increment, s.N})
+		case parse.NewStack:
 			techniques = append(techniques, Technique{NewStack, -1})
-		} else {
-			panic(fmt.Sprintln("unsupported techinue: ", line))
 		}
 	}
 
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(techniques))
-
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(techniques))
+	return techniques, nil
 }
@@ -1,3 +0,0 @@
-module github.com/stanciua/adventofcode2019
-
-go 1.18
@@ -0,0 +1,265 @@
+package day24
+
+import (
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+const (
+	Bug        rune = '#'
+	EmptySpace rune = '.'
+	Subgrid    rune = '?'
+)
+
+type Tile struct {
+	p     grid.Point
+	level int
+}
+
+// the tiles of a level, the middle one holds the inner level in part 2
+var (
+	bounds = grid.Rect{Max: grid.Point{X: 4, Y: 4}}
+	middle = grid.Point{X: 2, Y: 2}
+)
+
+func neighborsLevelTile(grids map[int]*grid.Dense[rune], tile Tile) []Tile {
+	neighbors := make([]Tile, 0)
+	for _, n := range tile.p.Neighbors4() {
+		if bounds.Contains(n) {
+			neighbors = append(neighbors, Tile{n, tile.level})
+		}
+	}
+
+	if _, ok := grids[tile.level-1]; ok {
+		// now check the outer level for first and last line/column, they
+		// border the tiles around the middle of the outer level
+		for _, d := range grid.Directions {
+			if !bounds.Contains(tile.p.Move(d)) {
+				neighbors = append(neighbors, Tile{middle.Move(d), tile.level - 1})
+			}
+		}
+	}
+
+	if _, ok := grids[tile.level+1]; ok {
+		// now check the inner level for (2,1), (3,2), (2, 3), (1,2), each of
+		// them borders the whole edge of the inner level facing it
+		if d, ok := grid.DirectionOf(middle.Sub(tile.p)); ok {
+			for _, p := range edge(d.Reverse()) {
+				neighbors = append(neighbors, Tile{p, tile.level + 1})
+			}
+		}
+	}
+
+	return neighbors
+}
+
+// the tiles of the edge of a level in the given direction
+func edge(d grid.Direction) []grid.Point {
+	center := middle.Move(d).Move(d)
+	side := d.TurnRight().Offset()
+	tiles := make([]grid.Point, 0, 5)
+	for i := -2; i <= 2; i++ {
+		tiles = append(tiles, grid.Point{X: center.X + i*side.X, Y: center.Y + i*side.Y})
+	}
+
+	return tiles
+}
+
+func countBugsInNeighbors(grids map[int]*grid.Dense[rune], neighbors []Tile) int {
+	count := 0
+	for _, t := range neighbors {
+		if grids[t.level].At(t.p) == Bug {
+			count++
+		}
+	}
+
+	return count
+}
+
+func computeNewGrid(grids map[int]*grid.Dense[rune], level int) (bool, *grid.Dense[rune]) {
+	infestation := false
+
+	area := grids[level]
+	newGrid := area.Clone()
+
+	area.Each(func(p grid.Point, sym rune) {
+		if len(grids) > 1 && p == middle {
+			return
+		}
+		neighbors := neighborsLevelTile(grids, Tile{p, level})
+		count := countBugsInNeighbors(grids, neighbors)
+		if sym == Bug {
+			// count is 1, the bug lives
+			if count == 1 {
+				infestation = true
+			} else {
+				newGrid.Set(p, EmptySpace)
+			}
+		} else {
+			// count is 1 or 2, the empty space becomes  a bug
+			if count == 1 || count == 2 {
+				newGrid.Set(p, Bug)
+				infestation = true
+			}
+		}
+	})
+
+	return infestation, newGrid
+}
+
+func simulateGrids(grids map[int]*grid.Dense[rune]) map[int]*grid.Dense[rune] {
+	nextGrids := make(map[int]*grid.Dense[rune])
+	infestation := false
+	replaceGrids(nextGrids, grids)
+
+	// level 0, 1, 2, ...
+	for level := 0; level <= len(grids); level++ {
+		var newGrid *grid.Dense[rune]
+		ok := false
+		if _, ok = grids[level]; !ok {
+			if infestation {
+				nextGrids[level] = emptyGrid()
+			}
+			break
+		}
+
+		infestation, newGrid = computeNewGrid(grids, level)
+		nextGrids[level] = newGrid
+	}
+
+	infestation = false
+	// level -1, -2, -3 ...
+	for level := -1; level >= -len(grids); level-- {
+		var newGrid *grid.Dense[rune]
+		ok := false
+		if _, ok = grids[level]; !ok {
+			if infestation {
+				nextGrids[level] = emptyGrid()
+			}
+			break
+		}
+
+		infestation, newGrid = computeNewGrid(grids, level)
+		nextGrids[level] = newGrid
+	}
+
+	return nextGrids
+}
+
+func computeBiodiversityRating(area *grid.Dense[rune]) int {
+	rating := 0
+	area.Each(func(p grid.Point, sym rune) {
+		if sym == Bug {
+			rating += 1 << (p.Y*bounds.Width() + p.X)
+		}
+	})
+
+	return rating
+}
+
+// the layout of the scanned area
+func layout(m *grid.Dense[rune]) *grid.Dense[rune] {
+	return m.Clone()
+}
+
+// let the bugs evolve until a layout appears twice and return it, onStep is
+// called with the layout after every minute when set
+func findRepeatedLayout(area *grid.Dense[rune], onStep func(area *grid.Dense[rune])) *grid.Dense[rune] {
+	// every layout has its own rating, a bit for every bug
+	matchLayout := make(map[int]bool)
+	matchLayout[computeBiodiversityRating(area)] = true
+	grids := make(map[int]*grid.Dense[rune])
+	grids[0] = area
+
+	for {
+		_, newArea := computeNewGrid(grids, 0)
+		if onStep != nil {
+			onStep(newArea)
+		}
+		rating := computeBiodiversityRating(newArea)
+		if matchLayout[rating] {
+			// found the same layout again, we are done
+			return newArea
+		}
+		matchLayout[rating] = true
+		grids[0] = newArea
+	}
+}
+
+func Part1(m *grid.Dense[rune]) int {
+	return computeBiodiversityRating(findRepeatedLayout(layout(m), nil))
+}
+
+// the levels at the start of part 2, with the empty levels around the scanned one
+func recursiveLayout(m *grid.Dense[rune]) map[int]*grid.Dense[rune] {
+	area := layout(m)
+	area.Set(middle, Subgrid)
+
+	grids := make(map[int]*grid.Dense[rune])
+	grids[0] = area
+	grids[-1] = emptyGrid()
+	grids[1] = emptyGrid()
+
+	return grids
+}
+
+// let the bugs evolve on the recursive levels for the given number of minutes,
+// onStep is called with the levels after every minute when set
+func simulate(grids map[int]*grid.Dense[rune], minutes int, onStep func(grids map[int]*grid.Dense[rune])) {
+	for i := 0; i < minutes; i++ {
+		newGrids := simulateGrids(grids)
+		replaceGrids(grids, newGrids)
+		if onStep != nil {
+			onStep(grids)
+		}
+	}
+}
+
+func Part2(m *grid.Dense[rune]) int {
+	grids := recursiveLayout(m)
+	simulate(grids, 200, nil)
+	return countBugs(grids)
+}
+
+// count the bugs on all the levels
+func countBugs(grids map[int]*grid.Dense[rune]) int {
+	count := 0
+	for _, area := range grids {
+		count += len(area.Find(func(sym rune) bool { return sym == Bug }))
+	}
+	return count
+}
+
+func replaceGrids(curr map[int]*grid.Dense[rune], next map[int]*grid.Dense[rune]) {
+	for k, v := range next {
+		curr[k] = v
+	}
+}
+
+func emptyGrid() *grid.Dense[rune] {
+	area := grid.NewDense(bounds.Width(), bounds.Height(), EmptySpace)
+	area.Set(middle, Subgrid)
+
+	return area
+}
+
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	m, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(m) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(m) }),
+	}, nil
+}
+
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (*grid.Dense[rune], error) {
+	return parse.File(path, parse.Grid(""))
+}
@@ -0,0 +1,54 @@
+package day24
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+const example = `....#
+#..#.
+#..##
+..#..
+#....`
+
+func TestExample(t *testing.T) {
+	m, err := parse.Grid("")("example", strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rating := Part1(m); rating != 2129920 {
+		t.Errorf("Expected a rating of 2129920 got %d", rating)
+	}
+
+	grids := recursiveLayout(m)
+	simulate(grids, 10, nil)
+	if bugs := countBugs(grids); bugs != 99 {
+		t.Errorf("Expected 99 bugs after 10 minutes got %d", bugs)
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		m, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part1(m)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		m, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part2(m)
+	}
+}
@@ -0,0 +1,85 @@
+package day24
+
+import (
+	"image/color"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+var palette = imaging.Palette{
+	Background: color.RGBA{0x10, 0x10, 0x20, 0xff},
+	Colors: map[rune]color.Color{
+		Bug:     color.RGBA{0x7c, 0xd9, 0x4a, 0xff},
+		Subgrid: color.RGBA{0x50, 0x50, 0x70, 0xff},
+	},
+}
+
+// the number of levels drawn side by side before starting a new row of levels
+const levelsPerRow = 16
+
+// Draw saves the evolution of the bugs into dir as animations, one for the
+// single level of part 1 until a layout repeats and one for the recursive
+// levels of part 2
+func Draw(path, dir string, scale int) error {
+	m, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	single := imaging.NewAnimation(palette, scale, 50)
+	area := layout(m)
+	single.Capture(renderGrid(area))
+	findRepeatedLayout(area, func(area *grid.Dense[rune]) {
+		single.Capture(renderGrid(area))
+	})
+	if err := single.Save(filepath.Join(dir, "day24-part1.gif")); err != nil {
+		return err
+	}
+
+	recursive := imaging.NewAnimation(palette, scale, 10)
+	grids := recursiveLayout(m)
+	recursive.Capture(renderLevels(grids))
+	simulate(grids, 200, func(grids map[int]*grid.Dense[rune]) {
+		recursive.Capture(renderLevels(grids))
+	})
+
+	return recursive.Save(filepath.Join(dir, "day24-part2.gif"))
+}
+
+func renderGrid(area *grid.Dense[rune]) []string {
+	return area.Render(func(sym rune) rune { return sym })
+}
+
+// draw the levels from the outermost to the innermost one, in rows of
+// levelsPerRow levels separated by an empty tile
+func renderLevels(grids map[int]*grid.Dense[rune]) []string {
+	levels := make([]int, 0, len(grids))
+	for level := range grids {
+		levels = append(levels, level)
+	}
+	sort.Ints(levels)
+
+	var rows []string
+	for first := 0; first < len(levels); first += levelsPerRow {
+		last := first + levelsPerRow
+		if last > len(levels) {
+			last = len(levels)
+		}
+		for y := 0; y < bounds.Height(); y++ {
+			var row strings.Builder
+			for _, level := range levels[first:last] {
+				area := grids[level]
+				row.WriteString(string(area.Row(y)))
+				row.WriteRune(EmptySpace)
+			}
+			rows = append(rows, row.String())
+		}
+		rows = append(rows, "")
+	}
+
+	return rows
+}
@@ -0,0 +1,45 @@
+package day24
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/terminal"
+)
+
+// Watch shows the bugs evolving on the terminal, first on the single level of
+// part 1 until a layout repeats and then for 200 minutes on the recursive
+// levels of part 2
+func Watch(path string, w io.Writer, fps int) error {
+	m, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	screen := terminal.NewScreen(w, palette, fps)
+	defer screen.Close()
+
+	minute := 0
+	findRepeatedLayout(layout(m), func(area *grid.Dense[rune]) {
+		if err != nil {
+			return
+		}
+		minute++
+		err = screen.Draw(renderGrid(area), fmt.Sprintf("minute %d  rating: %d", minute, computeBiodiversityRating(area)))
+	})
+	if err != nil {
+		return err
+	}
+
+	minute = 0
+	simulate(recursiveLayout(m), 200, func(grids map[int]*grid.Dense[rune]) {
+		if err != nil {
+			return
+		}
+		minute++
+		err = screen.Draw(renderLevels(grids), fmt.Sprintf("minute %d  levels: %d  bugs: %d", minute, len(grids), countBugs(grids)))
+	})
+
+	return err
+}
@@ -1,12 +1,12 @@
-package main
+package day25
 
 import (
-	"bufio"
 	"fmt"
-	"log"
-	"os"
 	"strconv"
 	"strings"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
 )
 
 const (
@@ -451,7 +451,7 @@ func (d *Droid) addRoomIfNotExplored(curr, next Move, explored map[[32]rune]bool
 	return visited
 }
 
-func part1(input []int64) int64 {
+func Part1(input []int64) int64 {
 	// Note: the code is specific to my inputs, it's not generic for other type of inputs
 	//       as it take too much to handle every bad input generically.
 	comp := Droid{vm: NewVM()}
@@ -583,38 +583,20 @@ func (d *Droid) input(command []rune) {
 	}
 }
 
-func main() {
-	file, err := os.Open("input/part1.txt")
-
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	program, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
-	}
-
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	// we have just one line, with elements separated by commas
-	if len(inputs) != 1 {
-		panic("The input should be only one line long!")
-	}
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(program) }),
+	}, nil
+}
 
-	var program []int64
-	for _, integer := range strings.Split(inputs[0], ",") {
-		if val, err := strconv.ParseInt(integer, 10, 64); err != nil {
-			panic(err)
-		} else {
-			program = append(program, val)
-		}
-	}
-	// part 1 solution
-	fmt.Println("The result to 1st part is: ", part1(program))
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]int64, error) {
+	return parse.File(path, parse.Intcode)
 }
@@ -0,0 +1,324 @@
+package day3
+
+import (
+	"sort"
+
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// Pair holds the crossings of two wires, given by their index in the input
+type Pair struct {
+	A         int
+	B         int
+	Crossings []Crossing
+}
+
+// Closest returns the crossing closest to the central port, false if the
+// wires never meet
+func (p Pair) Closest() (Crossing, bool) {
+	return p.best(Crossing.Distance)
+}
+
+// Fastest returns the crossing reached with the fewest combined steps, false
+// if the wires never meet
+func (p Pair) Fastest() (Crossing, bool) {
+	return p.best(Crossing.Steps)
+}
+
+func (p Pair) best(cost func(Crossing) int) (Crossing, bool) {
+	var best Crossing
+	found := false
+	for _, c := range p.Crossings {
+		if !found || cost(c) < cost(best) {
+			best = c
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// Overlaps returns the stretches where the two wires run along each other
+func (p Pair) Overlaps() []Crossing {
+	var overlaps []Crossing
+	for _, c := range p.Crossings {
+		if !c.Point() {
+			overlaps = append(overlaps, c)
+		}
+	}
+
+	return overlaps
+}
+
+// Analyzer finds the crossings of every pair of a number of wires
+type Analyzer struct {
+	Wires []Wire
+	// every pair of wires once, A before B
+	Pairs []Pair
+}
+
+// NewAnalyzer returns the analyzer of the wires with the given paths
+func NewAnalyzer(paths [][]parse.Move) *Analyzer {
+	a := &Analyzer{}
+	for _, path := range paths {
+		a.Wires = append(a.Wires, NewWire(path))
+	}
+	for i := range a.Wires {
+		for j := i + 1; j < len(a.Wires); j++ {
+			a.Pairs = append(a.Pairs, Pair{A: i, B: j, Crossings: Crossings(a.Wires[i], a.Wires[j])})
+		}
+	}
+
+	return a
+}
+
+// Pair returns the crossings of the two wires
+func (a *Analyzer) Pair(i, j int) Pair {
+	if i > j {
+		i, j = j, i
+	}
+	// the pairs of wire i start after those of the wires before it
+	n := len(a.Wires)
+	index := i*n - i*(i+1)/2 + j - i - 1
+
+	return a.Pairs[index]
+}
+
+// Meeting is a point, or a stretch along which they run together, where a
+// number of wires meet
+type Meeting struct {
+	From  grid.Point `json:"from"`
+	To    grid.Point `json:"to"`
+	Wires []int      `json:"wires"`
+}
+
+// a stretch of a pair of wires along a line
+type stretch struct {
+	lo   int
+	hi   int
+	a, b int
+}
+
+// the wires of the stretches covering the line between lo and hi
+func covering(stretches []stretch, lo, hi int) map[int]bool {
+	wires := make(map[int]bool)
+	for _, s := range stretches {
+		if s.lo <= lo && s.hi >= hi {
+			wires[s.a] = true
+			wires[s.b] = true
+		}
+	}
+
+	return wires
+}
+
+func sorted(wires map[int]bool) []int {
+	list := make([]int, 0, len(wires))
+	for w := range wires {
+		list = append(list, w)
+	}
+	sort.Ints(list)
+
+	return list
+}
+
+func same(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Meetings returns the points and stretches where at least k wires meet.
+// Stretches are as long as the same wires run together, a point where more
+// wires cross splits them
+func (a *Analyzer) Meetings(k int) []Meeting {
+	points := make(map[grid.Point]map[int]bool)
+	rows := make(map[int][]stretch)
+	columns := make(map[int][]stretch)
+	for _, pair := range a.Pairs {
+		for _, c := range pair.Crossings {
+			switch {
+			case c.Point():
+				if points[c.From] == nil {
+					points[c.From] = make(map[int]bool)
+				}
+				points[c.From][pair.A] = true
+				points[c.From][pair.B] = true
+			case c.From.Y == c.To.Y:
+				lo, hi := span(c.From.X, c.To.X)
+				rows[c.From.Y] = append(rows[c.From.Y], stretch{lo: lo, hi: hi, a: pair.A, b: pair.B})
+			default:
+				lo, hi := span(c.From.Y, c.To.Y)
+				columns[c.From.X] = append(columns[c.From.X], stretch{lo: lo, hi: hi, a: pair.A, b: pair.B})
+			}
+		}
+	}
+
+	var meetings []Meeting
+	// the points met by more wires than the stretches through them, which
+	// split those stretches
+	splitRows := make(map[int][]int)
+	splitColumns := make(map[int][]int)
+	for p, wires := range points {
+		row := covering(rows[p.Y], p.X, p.X)
+		column := covering(columns[p.X], p.Y, p.Y)
+		for _, along := range []map[int]bool{row, column} {
+			for w := range along {
+				wires[w] = true
+			}
+		}
+		// a direction covering all the wires reports the point in its
+		// stretch, the other one leaves it out
+		if len(wires) != len(row) {
+			splitRows[p.Y] = append(splitRows[p.Y], p.X)
+		}
+		if len(wires) != len(column) {
+			splitColumns[p.X] = append(splitColumns[p.X], p.Y)
+		}
+		if len(wires) != len(row) && len(wires) != len(column) && len(wires) >= k {
+			meetings = append(meetings, Meeting{From: p, To: p, Wires: sorted(wires)})
+		}
+	}
+
+	for y, stretches := range rows {
+		for _, m := range meetingsAlong(stretches, splitRows[y], k) {
+			meetings = append(meetings, Meeting{From: grid.Point{X: m.lo, Y: y}, To: grid.Point{X: m.hi, Y: y}, Wires: m.wires})
+		}
+	}
+	for x, stretches := range columns {
+		for _, m := range meetingsAlong(stretches, splitColumns[x], k) {
+			meetings = append(meetings, Meeting{From: grid.Point{X: x, Y: m.lo}, To: grid.Point{X: x, Y: m.hi}, Wires: m.wires})
+		}
+	}
+
+	sort.Slice(meetings, func(i, j int) bool {
+		if meetings[i].From.Y != meetings[j].From.Y {
+			return meetings[i].From.Y < meetings[j].From.Y
+		}
+		if meetings[i].From.X != meetings[j].From.X {
+			return meetings[i].From.X < meetings[j].From.X
+		}
+		if meetings[i].To.Y != meetings[j].To.Y {
+			return meetings[i].To.Y < meetings[j].To.Y
+		}
+		return meetings[i].To.X < meetings[j].To.X
+	})
+
+	return meetings
+}
+
+type meeting struct {
+	lo, hi int
+	wires  []int
+}
+
+// meetingsAlong returns the parts of a line where at least k wires run
+// together, leaving out the split points
+func meetingsAlong(stretches []stretch, splits []int, k int) []meeting {
+	skip := make(map[int]bool)
+	var bounds []int
+	for _, s := range stretches {
+		bounds = append(bounds, s.lo, s.hi)
+	}
+	for _, split := range splits {
+		skip[split] = true
+		bounds = append(bounds, split)
+	}
+	sort.Ints(bounds)
+
+	// the line is cut into the bounds and the gaps between them, every part
+	// covered by the same stretches
+	var parts [][2]int
+	for i, b := range bounds {
+		if i > 0 && b == bounds[i-1] {
+			continue
+		}
+		if len(parts) > 0 {
+			if last := parts[len(parts)-1][1]; b-last > 1 {
+				parts = append(parts, [2]int{last + 1, b - 1})
+			}
+		}
+		parts = append(parts, [2]int{b, b})
+	}
+
+	var meetings []meeting
+	for _, part := range parts {
+		if part[0] == part[1] && skip[part[0]] {
+			continue
+		}
+		wires := sorted(covering(stretches, part[0], part[1]))
+		if len(wires) < 2 || len(wires) < k {
+			continue
+		}
+		// parts next to each other met by the same wires are joined
+		if n := len(meetings); n > 0 && meetings[n-1].hi == part[0]-1 && same(meetings[n-1].wires, wires) {
+			meetings[n-1].hi = part[1]
+			continue
+		}
+		meetings = append(meetings, meeting{lo: part[0], hi: part[1], wires: wires})
+	}
+
+	return meetings
+}
+
+// Overlap is a stretch where two wires run along each other
+type Overlap struct {
+	From grid.Point `json:"from"`
+	To   grid.Point `json:"to"`
+}
+
+// PairReport sums up the crossings of two wires
+type PairReport struct {
+	Wires     [2]int `json:"wires"`
+	Crossings int    `json:"crossings"`
+	// the crossing closest to the central port
+	Closest  *grid.Point `json:"closest,omitempty"`
+	Distance int         `json:"distance,omitempty"`
+	// the crossing reached with the fewest combined steps
+	Fastest  *grid.Point `json:"fastest,omitempty"`
+	Steps    int         `json:"steps,omitempty"`
+	Overlaps []Overlap   `json:"overlaps,omitempty"`
+}
+
+// Report sums up the crossings of every pair of wires, with the meetings of
+// at least k wires when k is set
+type Report struct {
+	Pairs    []PairReport `json:"pairs"`
+	Meetings []Meeting    `json:"meetings,omitempty"`
+}
+
+// Report returns the report of the wires, with the meetings of at least k
+// wires unless k is 0
+func (a *Analyzer) Report(k int) Report {
+	var r Report
+	for _, pair := range a.Pairs {
+		pr := PairReport{Wires: [2]int{pair.A, pair.B}, Crossings: len(pair.Crossings)}
+		if c, ok := pair.Closest(); ok {
+			closest := c.Closest()
+			pr.Closest = &closest
+			pr.Distance = c.Distance()
+		}
+		if c, ok := pair.Fastest(); ok {
+			first := c.First()
+			pr.Fastest = &first
+			pr.Steps = c.Steps()
+		}
+		for _, c := range pair.Overlaps() {
+			pr.Overlaps = append(pr.Overlaps, Overlap{From: c.From, To: c.To})
+		}
+		r.Pairs = append(r.Pairs, pr)
+	}
+	if k > 0 {
+		r.Meetings = a.Meetings(k)
+	}
+
+	return r
+}
@@ -0,0 +1,87 @@
+package day3
+
+import (
+	"fmt"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	inputs, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	crossings := Crossings(NewWire(inputs[0]), NewWire(inputs[1]))
+
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(crossings) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(crossings) }),
+	}, nil
+}
+
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([][]parse.Move, error) {
+	wires, err := ReadWires(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// we have just two wires, one on each line
+	if len(wires) != 2 {
+		line := 2
+		if len(wires) > 2 {
+			line = 3
+		}
+		return nil, &parse.Error{File: path, Line: line, Msg: fmt.Sprintf("expected 2 wires, got %d", len(wires))}
+	}
+
+	return wires, nil
+}
+
+// ReadWires reads the paths of any number of wires from the given file, one
+// on every line
+func ReadWires(path string) ([][]parse.Move, error) {
+	wires, err := parse.File(path, parse.Wires)
+	if err != nil {
+		return nil, err
+	}
+	if len(wires) == 0 {
+		return nil, &parse.Error{File: path, Line: 1, Msg: "no wires"}
+	}
+
+	return wires, nil
+}
+
+// Part1 returns the Manhattan distance from the central port to the closest
+// crossing
+func Part1(crossings []Crossing) int {
+	min := int(^uint(0) >> 1)
+	for _, c := range crossings {
+		if distance := c.Distance(); distance < min {
+			min = distance
+		}
+	}
+
+	return min
+}
+
+// Part2 returns the fewest combined steps the wires walk to reach a crossing
+func Part2(crossings []Crossing) int {
+	min := int(^uint(0) >> 1)
+	for _, c := range crossings {
+		if steps := c.Steps(); steps < min {
+			min = steps
+		}
+	}
+
+	return min
+}
+
+// both wires start from the central port
+var origin = grid.Point{}
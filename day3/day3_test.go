@@ -0,0 +1,158 @@
+package day3
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+func TestCrossings(t *testing.T) {
+	tests := []struct {
+		paths    string
+		distance int
+		steps    int
+	}{
+		{"R8,U5,L5,D3\nU7,R6,D4,L4", 6, 30},
+		{"R75,D30,R83,U83,L12,D49,R71,U7,L72\nU62,R66,U55,R34,D71,R55,D58,R83", 159, 610},
+		{"R98,U47,R26,D63,R33,U87,L62,D20,R33,U53,R51\nU98,R91,D20,R16,D67,R40,U7,R15,U6,R7", 135, 410},
+		// the wires run along each other, on both sides of the central port
+		{"L5,R10\nR3,L6", 1, 8},
+		// millions of squares long
+		{"R5000000,U5000000\nU3000000,R6000000", 8000000, 16000000},
+	}
+
+	for _, test := range tests {
+		wires, err := parse.Wires("example", strings.NewReader(test.paths))
+		if err != nil {
+			t.Fatal(err)
+		}
+		crossings := Crossings(NewWire(wires[0]), NewWire(wires[1]))
+
+		if distance := Part1(crossings); distance != test.distance {
+			t.Errorf("%q: expected distance %d got %d", test.paths, test.distance, distance)
+		}
+		if steps := Part2(crossings); steps != test.steps {
+			t.Errorf("%q: expected %d steps got %d", test.paths, test.steps, steps)
+		}
+	}
+}
+
+func TestAnalyzer(t *testing.T) {
+	paths, err := parse.Wires("example", strings.NewReader("R8,U5,L5,D3\nU7,R6,D4,L4\nR6,U3,L10"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAnalyzer(paths)
+
+	if len(a.Pairs) != 3 {
+		t.Fatalf("Expected 3 pairs got %d", len(a.Pairs))
+	}
+	for _, pair := range a.Pairs {
+		if p := a.Pair(pair.B, pair.A); p.A != pair.A || p.B != pair.B {
+			t.Errorf("Expected pair %d, %d got %d, %d", pair.A, pair.B, p.A, p.B)
+		}
+	}
+
+	closest, _ := a.Pair(0, 1).Closest()
+	fastest, _ := a.Pair(0, 1).Fastest()
+	if closest.Distance() != 6 || fastest.Steps() != 30 {
+		t.Errorf("Expected distance 6 and 30 steps got %d and %d", closest.Distance(), fastest.Steps())
+	}
+	overlaps := a.Pair(1, 2).Overlaps()
+	if len(overlaps) != 1 || overlaps[0].From != (grid.Point{X: 2, Y: -3}) || overlaps[0].To != (grid.Point{X: 6, Y: -3}) {
+		t.Errorf("Expected the wires 1 and 2 to overlap from 2,-3 to 6,-3 got %v", overlaps)
+	}
+
+	// the third wire splits the overlap of the other two where the first
+	// wire crosses it
+	meetings := a.Meetings(2)
+	expected := []Meeting{
+		{From: grid.Point{X: 6, Y: -5}, To: grid.Point{X: 6, Y: -5}, Wires: []int{0, 1}},
+		{From: grid.Point{X: 0, Y: -3}, To: grid.Point{X: 0, Y: -3}, Wires: []int{1, 2}},
+		{From: grid.Point{X: 2, Y: -3}, To: grid.Point{X: 2, Y: -3}, Wires: []int{1, 2}},
+		{From: grid.Point{X: 3, Y: -3}, To: grid.Point{X: 3, Y: -3}, Wires: []int{0, 1, 2}},
+		{From: grid.Point{X: 4, Y: -3}, To: grid.Point{X: 6, Y: -3}, Wires: []int{1, 2}},
+		{From: grid.Point{X: 1, Y: 0}, To: grid.Point{X: 6, Y: 0}, Wires: []int{0, 2}},
+	}
+	if !reflect.DeepEqual(meetings, expected) {
+		t.Errorf("Expected meetings %v got %v", expected, meetings)
+	}
+	if meetings := a.Meetings(3); len(meetings) != 1 || meetings[0].From != (grid.Point{X: 3, Y: -3}) {
+		t.Errorf("Expected all the wires to meet at 3,-3 got %v", meetings)
+	}
+
+	// wires running along a row and along a column through the same point
+	tests := []struct {
+		paths string
+		k     int
+		point grid.Point
+	}{
+		{"U3,D1,D3\nU1,L3,R4\nU3,L2,D2,R3", 2, grid.Point{X: 0, Y: -1}},
+		{"D3,U2,U2,R4,L1\nR3,U3,L3,D2,R3\nU3,D1", 3, grid.Point{X: 0, Y: -1}},
+	}
+	for _, test := range tests {
+		paths, err := parse.Wires("example", strings.NewReader(test.paths))
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := false
+		for _, m := range NewAnalyzer(paths).Meetings(test.k) {
+			if m.From == test.point && m.To == test.point && reflect.DeepEqual(m.Wires, []int{0, 1, 2}) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%q: expected all the wires to meet at %v", test.paths, test.point)
+		}
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	paths, err := parse.Wires("example", strings.NewReader("R8,U5,L5,D3\nU7,R6,D4,L4\nR6,U3,L10"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := NewAnalyzer(paths).WriteSVG(&b); err != nil {
+		t.Fatal(err)
+	}
+	svg := b.String()
+
+	if n := strings.Count(svg, "<polyline"); n != 3 {
+		t.Errorf("Expected 3 wires got %d", n)
+	}
+	for _, title := range []string{"closest crossing at 1,0, distance 1", "fastest crossing at 1,0, 2 steps", "wires 1 and 2 from 2,-3 to 6,-3"} {
+		if !strings.Contains(svg, title) {
+			t.Errorf("Expected %q in the picture", title)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		inputs, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		// finding the crossings of the wires is part of the solution
+		Part1(Crossings(NewWire(inputs[0]), NewWire(inputs[1])))
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		inputs, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		// finding the crossings of the wires is part of the solution
+		Part2(Crossings(NewWire(inputs[0]), NewWire(inputs[1])))
+	}
+}
@@ -0,0 +1,104 @@
+package day3
+
+import (
+	"image/color"
+	"path/filepath"
+
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+var palette = imaging.Palette{
+	Background: color.RGBA{0x10, 0x10, 0x20, 0xff},
+	Colors: map[rune]color.Color{
+		'1': color.RGBA{0x4a, 0x9c, 0xd9, 0xff},
+		'2': color.RGBA{0xd9, 0x8c, 0x4a, 0xff},
+		'X': color.RGBA{0xff, 0xff, 0xff, 0xff},
+		'o': color.RGBA{0xff, 0x40, 0x40, 0xff},
+	},
+}
+
+// the wires span thousands of squares, the picture is shrunk so that its
+// longest side has at most this many cells
+const maxCells = 1000
+
+// Draw saves the picture of both wires into dir, the crossings and the central
+// port drawn over the wires, both as PNG and as SVG
+func Draw(path, dir string, scale int) error {
+	inputs, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	analyzer := NewAnalyzer(inputs)
+	if err := analyzer.SaveSVG(filepath.Join(dir, "day3-wires.svg")); err != nil {
+		return err
+	}
+	a, b := analyzer.Wires[0], analyzer.Wires[1]
+	return imaging.SavePNG(filepath.Join(dir, "day3-wires.png"), renderWires(a, b, analyzer.Pairs[0].Crossings), palette, scale)
+}
+
+// draw the wires shrunk to at most maxCells per side, every cell showing the
+// most important symbol of the squares it covers
+func renderWires(a, b Wire, crossings []Crossing) []string {
+	bounds := a.Bounds()
+	bounds = bounds.Extend(b.Bounds().Min).Extend(b.Bounds().Max)
+	size := bounds.Width()
+	if bounds.Height() > size {
+		size = bounds.Height()
+	}
+	factor := size/maxCells + 1
+
+	cells := make([][]rune, bounds.Height()/factor+1)
+	for y := range cells {
+		cells[y] = make([]rune, bounds.Width()/factor+1)
+		for x := range cells[y] {
+			cells[y][x] = ' '
+		}
+	}
+	priority := map[rune]int{' ': 0, '1': 1, '2': 2, 'X': 3, 'o': 4}
+	cell := func(p grid.Point) grid.Point {
+		return grid.Point{X: (p.X - bounds.Min.X) / factor, Y: (p.Y - bounds.Min.Y) / factor}
+	}
+	mark := func(c grid.Point, symbol rune) {
+		if priority[symbol] > priority[cells[c.Y][c.X]] {
+			cells[c.Y][c.X] = symbol
+		}
+	}
+	// marks every cell covered by the straight line between the two points,
+	// walking the cells and not the squares of the wire
+	line := func(from, to grid.Point, symbol rune) {
+		c, end := cell(from), cell(to)
+		mark(c, symbol)
+		for c != end {
+			if c.X < end.X {
+				c.X++
+			} else if c.X > end.X {
+				c.X--
+			} else if c.Y < end.Y {
+				c.Y++
+			} else {
+				c.Y--
+			}
+			mark(c, symbol)
+		}
+	}
+
+	for _, s := range a {
+		line(s.From, s.To, '1')
+	}
+	for _, s := range b {
+		line(s.From, s.To, '2')
+	}
+	for _, c := range crossings {
+		line(c.From, c.To, 'X')
+	}
+	mark(cell(origin), 'o')
+
+	rows := make([]string, len(cells))
+	for y, row := range cells {
+		rows[y] = string(row)
+	}
+
+	return rows
+}
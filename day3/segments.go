@@ -0,0 +1,330 @@
+package day3
+
+import (
+	"sort"
+
+	"github.com/stanciua/adventofcode2019/grid"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// Segment is a straight piece of a wire, walked from From to To
+type Segment struct {
+	From grid.Point
+	To   grid.Point
+	// the steps walked along the wire before reaching From
+	Steps int
+}
+
+// Horizontal reports whether the segment runs along the X axis
+func (s Segment) Horizontal() bool {
+	return s.From.Y == s.To.Y
+}
+
+// Len returns the number of steps from one end of the segment to the other
+func (s Segment) Len() int {
+	return s.From.Manhattan(s.To)
+}
+
+// StepsTo returns the steps walked along the wire to reach the point, which
+// must be on the segment
+func (s Segment) StepsTo(p grid.Point) int {
+	return s.Steps + s.From.Manhattan(p)
+}
+
+// Wire is the path of a wire as the segments it is made of, starting at the
+// central port
+type Wire []Segment
+
+// NewWire returns the segments walked by the moves of a wire path
+func NewWire(path []parse.Move) Wire {
+	wire := make(Wire, 0, len(path))
+	curr := origin
+	steps := 0
+	for _, move := range path {
+		offset := move.Direction.Offset()
+		next := curr.Add(grid.Point{X: offset.X * move.Steps, Y: offset.Y * move.Steps})
+		wire = append(wire, Segment{From: curr, To: next, Steps: steps})
+		curr = next
+		steps += move.Steps
+	}
+
+	return wire
+}
+
+// Len returns the number of steps along the whole wire
+func (w Wire) Len() int {
+	if len(w) == 0 {
+		return 0
+	}
+	last := w[len(w)-1]
+
+	return last.Steps + last.Len()
+}
+
+// Bounds returns the smallest rectangle holding the wire and the central port
+func (w Wire) Bounds() grid.Rect {
+	r := grid.Rect{Min: origin, Max: origin}
+	for _, s := range w {
+		r = r.Extend(s.To)
+	}
+
+	return r
+}
+
+// Crossing is a place where two wires meet, a single point when From and To
+// are the same and otherwise the stretch where the wires run along each other
+type Crossing struct {
+	From grid.Point
+	To   grid.Point
+	// the segments of the two wires meeting here
+	A Segment
+	B Segment
+}
+
+// Point reports whether the wires meet in a single point
+func (c Crossing) Point() bool {
+	return c.From == c.To
+}
+
+// Closest returns the point of the crossing closest to the central port
+func (c Crossing) Closest() grid.Point {
+	return grid.Point{X: clamp(origin.X, c.From.X, c.To.X), Y: clamp(origin.Y, c.From.Y, c.To.Y)}
+}
+
+// Distance returns the Manhattan distance from the central port to the
+// closest point of the crossing
+func (c Crossing) Distance() int {
+	return origin.Manhattan(c.Closest())
+}
+
+// First returns the point of the crossing reached with the fewest steps of
+// both wires combined; along a stretch the steps change linearly, so it is
+// always one of its ends
+func (c Crossing) First() grid.Point {
+	if c.A.StepsTo(c.To)+c.B.StepsTo(c.To) < c.A.StepsTo(c.From)+c.B.StepsTo(c.From) {
+		return c.To
+	}
+
+	return c.From
+}
+
+// Steps returns the fewest steps both wires combined walk to reach the
+// crossing
+func (c Crossing) Steps() int {
+	first := c.First()
+
+	return c.A.StepsTo(first) + c.B.StepsTo(first)
+}
+
+func clamp(v, a, b int) int {
+	if a > b {
+		a, b = b, a
+	}
+	if v < a {
+		return a
+	}
+	if v > b {
+		return b
+	}
+
+	return v
+}
+
+// Crossings returns all the places where the two wires meet, leaving out the
+// central port both of them start from. The segments crossing each other are
+// found by sweeping a vertical line from left to right, so the work depends on
+// the number of segments and crossings and not on the length of the wires
+func Crossings(a, b Wire) []Crossing {
+	var crossings []Crossing
+	add := func(c Crossing) {
+		crossings = append(crossings, c)
+	}
+
+	perpendicular(horizontals(a), verticals(b), func(h, v Segment) {
+		if p := (grid.Point{X: v.From.X, Y: h.From.Y}); p != origin {
+			add(Crossing{From: p, To: p, A: h, B: v})
+		}
+	})
+	perpendicular(horizontals(b), verticals(a), func(h, v Segment) {
+		if p := (grid.Point{X: v.From.X, Y: h.From.Y}); p != origin {
+			add(Crossing{From: p, To: p, A: v, B: h})
+		}
+	})
+	collinear(horizontals(a), horizontals(b), false, add)
+	collinear(verticals(a), verticals(b), true, add)
+
+	return crossings
+}
+
+func horizontals(w Wire) []Segment {
+	var segments []Segment
+	for _, s := range w {
+		if s.Horizontal() {
+			segments = append(segments, s)
+		}
+	}
+
+	return segments
+}
+
+func verticals(w Wire) []Segment {
+	var segments []Segment
+	for _, s := range w {
+		if !s.Horizontal() {
+			segments = append(segments, s)
+		}
+	}
+
+	return segments
+}
+
+// the kinds of sweep events, ordered so that segments touching at their ends
+// still cross
+const (
+	insert = iota
+	query
+	remove
+)
+
+type event struct {
+	x       int
+	kind    int
+	segment Segment
+}
+
+// perpendicular calls found for every horizontal segment crossing a vertical
+// one, the ends included
+func perpendicular(horizontals, verticals []Segment, found func(h, v Segment)) {
+	events := make([]event, 0, 2*len(horizontals)+len(verticals))
+	for _, h := range horizontals {
+		lo, hi := span(h.From.X, h.To.X)
+		events = append(events, event{x: lo, kind: insert, segment: h}, event{x: hi, kind: remove, segment: h})
+	}
+	for _, v := range verticals {
+		events = append(events, event{x: v.From.X, kind: query, segment: v})
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].x != events[j].x {
+			return events[i].x < events[j].x
+		}
+		return events[i].kind < events[j].kind
+	})
+
+	// the horizontal segments under the sweep line, ordered by Y
+	var active []Segment
+	below := func(y int) int {
+		return sort.Search(len(active), func(i int) bool { return active[i].From.Y >= y })
+	}
+	for _, e := range events {
+		switch e.kind {
+		case insert:
+			i := below(e.segment.From.Y)
+			active = append(active, Segment{})
+			copy(active[i+1:], active[i:])
+			active[i] = e.segment
+		case remove:
+			for i := below(e.segment.From.Y); i < len(active); i++ {
+				if active[i] == e.segment {
+					active = append(active[:i], active[i+1:]...)
+					break
+				}
+			}
+		case query:
+			lo, hi := span(e.segment.From.Y, e.segment.To.Y)
+			for i := below(lo); i < len(active) && active[i].From.Y <= hi; i++ {
+				found(active[i], e.segment)
+			}
+		}
+	}
+}
+
+// interval is a segment seen along the line it lies on
+type interval struct {
+	line    int
+	lo      int
+	hi      int
+	segment Segment
+	wire    int
+}
+
+// collinear adds the stretches where segments of the two wires lying on the
+// same line overlap, the segments are vertical when flipped is set
+func collinear(a, b []Segment, flipped bool, add func(Crossing)) {
+	intervals := make([]interval, 0, len(a)+len(b))
+	for wire, segments := range [][]Segment{a, b} {
+		for _, s := range segments {
+			from, to := s.From, s.To
+			if flipped {
+				from, to = flip(from), flip(to)
+			}
+			lo, hi := span(from.X, to.X)
+			intervals = append(intervals, interval{line: from.Y, lo: lo, hi: hi, segment: s, wire: wire})
+		}
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		if intervals[i].line != intervals[j].line {
+			return intervals[i].line < intervals[j].line
+		}
+		return intervals[i].lo < intervals[j].lo
+	})
+
+	point := func(line, x int) grid.Point {
+		if flipped {
+			return grid.Point{X: line, Y: x}
+		}
+		return grid.Point{X: x, Y: line}
+	}
+	overlap := func(line, lo, hi int, sa, sb Segment) {
+		// the central port is left out, splitting the stretch around it
+		if p := point(line, 0); p == origin && lo <= 0 && hi >= 0 {
+			if lo < 0 {
+				add(Crossing{From: point(line, lo), To: point(line, -1), A: sa, B: sb})
+			}
+			if hi > 0 {
+				add(Crossing{From: point(line, 1), To: point(line, hi), A: sa, B: sb})
+			}
+			return
+		}
+		add(Crossing{From: point(line, lo), To: point(line, hi), A: sa, B: sb})
+	}
+
+	// the intervals of each wire still reaching the current one
+	var active [2][]interval
+	for i, curr := range intervals {
+		if i > 0 && intervals[i-1].line != curr.line {
+			active = [2][]interval{}
+		}
+		other := 1 - curr.wire
+		kept := active[other][:0]
+		for _, prev := range active[other] {
+			if prev.hi < curr.lo {
+				continue
+			}
+			kept = append(kept, prev)
+			hi := prev.hi
+			if curr.hi < hi {
+				hi = curr.hi
+			}
+			if curr.wire == 0 {
+				overlap(curr.line, curr.lo, hi, curr.segment, prev.segment)
+			} else {
+				overlap(curr.line, curr.lo, hi, prev.segment, curr.segment)
+			}
+		}
+		active[other] = kept
+		active[curr.wire] = append(active[curr.wire], curr)
+	}
+}
+
+func flip(p grid.Point) grid.Point {
+	return grid.Point{X: p.Y, Y: p.X}
+}
+
+// span returns the two coordinates in increasing order
+func span(a, b int) (int, int) {
+	if a > b {
+		return b, a
+	}
+
+	return a, b
+}
@@ -0,0 +1,110 @@
+package day3
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+// the width of the pictures in pixels, the height follows the wires
+const svgWidth = 1000
+
+// the hue of the first wire, every next one turns by the golden angle so that
+// any number of wires get colors far apart
+const (
+	firstHue   = 207
+	goldenTurn = 137.508
+)
+
+func wireColor(i int) string {
+	hue := int(firstHue+float64(i)*goldenTurn) % 360
+	return fmt.Sprintf("hsl(%d, 65%%, 57%%)", hue)
+}
+
+// WriteSVG draws the wires of the analyzer as SVG, every wire in its own
+// color starting from the central port, with their crossings marked and the
+// closest crossing by distance and by steps highlighted. The picture keeps
+// the coordinates of the wires, so it stays the same size however long they
+// are
+func (a *Analyzer) WriteSVG(w io.Writer) error {
+	bounds := grid.Rect{Min: origin, Max: origin}
+	for _, wire := range a.Wires {
+		b := wire.Bounds()
+		bounds = bounds.Extend(b.Min).Extend(b.Max)
+	}
+	size := bounds.Width()
+	if bounds.Height() > size {
+		size = bounds.Height()
+	}
+	// markers and margins are sized on the whole picture
+	unit := float64(size) / 400
+	if unit < 0.25 {
+		unit = 0.25
+	}
+	margin := 4 * unit
+	width, height := float64(bounds.Width())+2*margin, float64(bounds.Height())+2*margin
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%.0f" viewBox="%g %g %g %g">`+"\n",
+		svgWidth, svgWidth*height/width, float64(bounds.Min.X)-margin, float64(bounds.Min.Y)-margin, width, height)
+	fmt.Fprintf(out, `<rect x="%g" y="%g" width="100%%" height="100%%" fill="#101020"/>`+"\n", float64(bounds.Min.X)-margin, float64(bounds.Min.Y)-margin)
+
+	for i, wire := range a.Wires {
+		fmt.Fprintf(out, `<polyline fill="none" stroke="%s" stroke-width="1.5" vector-effect="non-scaling-stroke" points="%d,%d`, wireColor(i), origin.X, origin.Y)
+		for _, s := range wire {
+			fmt.Fprintf(out, " %d,%d", s.To.X, s.To.Y)
+		}
+		fmt.Fprintf(out, `"><title>wire %d, %d steps</title></polyline>`+"\n", i, wire.Len())
+	}
+
+	var closest, fastest Crossing
+	found := false
+	for _, pair := range a.Pairs {
+		for _, c := range pair.Crossings {
+			if c.Point() {
+				fmt.Fprintf(out, `<circle cx="%d" cy="%d" r="%g" fill="white"><title>wires %d and %d at %d,%d</title></circle>`+"\n",
+					c.From.X, c.From.Y, unit, pair.A, pair.B, c.From.X, c.From.Y)
+			} else {
+				fmt.Fprintf(out, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="white" stroke-width="4" vector-effect="non-scaling-stroke"><title>wires %d and %d from %d,%d to %d,%d</title></line>`+"\n",
+					c.From.X, c.From.Y, c.To.X, c.To.Y, pair.A, pair.B, c.From.X, c.From.Y, c.To.X, c.To.Y)
+			}
+			if !found || c.Distance() < closest.Distance() {
+				closest = c
+			}
+			if !found || c.Steps() < fastest.Steps() {
+				fastest = c
+			}
+			found = true
+		}
+	}
+
+	if found {
+		p := closest.Closest()
+		fmt.Fprintf(out, `<circle cx="%d" cy="%d" r="%g" fill="none" stroke="#ff4040" stroke-width="2" vector-effect="non-scaling-stroke"><title>closest crossing at %d,%d, distance %d</title></circle>`+"\n",
+			p.X, p.Y, 4*unit, p.X, p.Y, closest.Distance())
+		p = fastest.First()
+		fmt.Fprintf(out, `<circle cx="%d" cy="%d" r="%g" fill="none" stroke="#40ff80" stroke-width="2" vector-effect="non-scaling-stroke"><title>fastest crossing at %d,%d, %d steps</title></circle>`+"\n",
+			p.X, p.Y, 6*unit, p.X, p.Y, fastest.Steps())
+	}
+	fmt.Fprintf(out, `<circle cx="%d" cy="%d" r="%g" fill="#ff4040"><title>central port</title></circle>`+"\n", origin.X, origin.Y, 2*unit)
+	fmt.Fprintln(out, "</svg>")
+
+	return out.Flush()
+}
+
+// SaveSVG draws the wires of the analyzer into an SVG file, see WriteSVG
+func (a *Analyzer) SaveSVG(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := a.WriteSVG(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
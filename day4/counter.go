@@ -0,0 +1,215 @@
+package day4
+
+// counter counts and lists the passwords of a policy digit by digit, without
+// going through every number of the range. Numbers sharing the digits left to
+// choose, the digits already chosen, the last of them, the length of its run
+// and the run rules already met have the same number of valid endings, which
+// is only worked out once
+type counter struct {
+	policy Policy
+	// the length all the length rules agree on, -1 for any and -2 for none
+	length        int
+	nonDecreasing bool
+	allowed       [10]bool
+	some          []func(length int) bool
+	every         []func(length int) bool
+
+	// the digits of the bounds, the lower one padded with zeros
+	lo, hi []int
+	memo   map[state]int64
+}
+
+// the choices left once the bounds no longer matter
+type state struct {
+	pos    int
+	length int
+	last   int
+	run    int
+	// the some run rules already met
+	met uint64
+}
+
+// newCounter returns the counter of the policy, false if one of its rules
+// can only be checked on whole passwords
+func newCounter(p Policy) (*counter, bool) {
+	c := &counter{policy: p, length: -1}
+	for d := range c.allowed {
+		c.allowed[d] = true
+	}
+	for _, r := range p {
+		switch r.kind {
+		case lengthRule:
+			if c.length == -1 || c.length == r.n {
+				c.length = r.n
+			} else {
+				c.length = -2
+			}
+		case nonDecreasingRule:
+			c.nonDecreasing = true
+		case someRunRule:
+			c.some = append(c.some, r.run)
+		case everyRunRule:
+			c.every = append(c.every, r.run)
+		case digitsRule:
+			for d := range c.allowed {
+				c.allowed[d] = c.allowed[d] && r.digits[d]
+			}
+		default:
+			return nil, false
+		}
+	}
+	if len(c.some) > 64 {
+		return nil, false
+	}
+
+	return c, true
+}
+
+// walk goes through the passwords from min to max in order, calling emit for
+// every valid one until it returns false; with no emit it only counts them
+func (c *counter) walk(min, max int64, emit func(password int64) bool) int64 {
+	if min < 0 {
+		min = 0
+	}
+	if min > max {
+		return 0
+	}
+	c.hi = digitsOf(max)
+	c.lo = make([]int, len(c.hi))
+	low := digitsOf(min)
+	copy(c.lo[len(c.hi)-len(low):], low)
+	c.memo = make(map[state]int64)
+
+	count, _ := c.step(0, true, true, state{last: -1}, 0, emit)
+	return count
+}
+
+// step chooses the digit at pos, returning the number of valid passwords
+// found below and whether to go on
+func (c *counter) step(pos int, tightLo, tightHi bool, s state, prefix int64, emit func(int64) bool) (int64, bool) {
+	if pos == len(c.hi) {
+		if !c.accept(s) {
+			return 0, true
+		}
+		if emit != nil {
+			return 1, emit(prefix)
+		}
+		return 1, true
+	}
+	if !tightLo && !tightHi && emit == nil {
+		return c.free(pos, s), true
+	}
+
+	from, to := 0, 9
+	if tightLo {
+		from = c.lo[pos]
+	}
+	if tightHi {
+		to = c.hi[pos]
+	}
+	var count int64
+	for d := from; d <= to; d++ {
+		next, ok := c.next(s, d)
+		if !ok {
+			continue
+		}
+		lo, hi := tightLo && d == from, tightHi && d == to
+		// the endings of a free state are counted first to skip the dead ones
+		if emit != nil && !lo && !hi && c.free(pos+1, next) == 0 {
+			continue
+		}
+		n, more := c.step(pos+1, lo, hi, next, prefix*10+int64(d), emit)
+		count += n
+		if !more {
+			return count, false
+		}
+	}
+
+	return count, true
+}
+
+// free counts the valid endings of a state once the bounds no longer matter
+func (c *counter) free(pos int, s state) int64 {
+	if pos == len(c.hi) {
+		if c.accept(s) {
+			return 1
+		}
+		return 0
+	}
+	key := s
+	key.pos = pos
+	if count, ok := c.memo[key]; ok {
+		return count
+	}
+
+	var count int64
+	for d := 0; d <= 9; d++ {
+		if next, ok := c.next(s, d); ok {
+			count += c.free(pos+1, next)
+		}
+	}
+	c.memo[key] = count
+
+	return count
+}
+
+// next returns the state after the digit, false if no valid password can
+// follow; the leading zeros are not part of the password
+func (c *counter) next(s state, d int) (state, bool) {
+	if s.length == 0 && d == 0 {
+		return s, true
+	}
+	if !c.allowed[d] || c.length >= 0 && s.length == c.length || c.length == -2 {
+		return s, false
+	}
+	if s.length > 0 && c.nonDecreasing && d < s.last {
+		return s, false
+	}
+
+	if s.length > 0 && d == s.last {
+		s.run++
+	} else {
+		var ok bool
+		if s, ok = c.endRun(s); !ok {
+			return s, false
+		}
+		s.run = 1
+	}
+	s.last = d
+	s.length++
+
+	return s, true
+}
+
+// endRun checks the run ending at the last digit against the run rules
+func (c *counter) endRun(s state) (state, bool) {
+	if s.length == 0 {
+		return s, true
+	}
+	for _, ok := range c.every {
+		if !ok(s.run) {
+			return s, false
+		}
+	}
+	for i, ok := range c.some {
+		if ok(s.run) {
+			s.met |= 1 << i
+		}
+	}
+
+	return s, true
+}
+
+// accept reports whether the password ending in the state is valid
+func (c *counter) accept(s state) bool {
+	// zero has no digits once the leading zeros are left out
+	if s.length == 0 {
+		return c.policy.Valid(0)
+	}
+	s, ok := c.endRun(s)
+	if !ok {
+		return false
+	}
+
+	return s.met == 1<<len(c.some)-1 && (c.length == -1 || s.length == c.length)
+}
@@ -0,0 +1,44 @@
+package day4
+
+import (
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	rmin, rmax, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(rmin, rmax) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(rmin, rmax) }),
+	}, nil
+}
+
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (int, int, error) {
+	interval, err := parse.File(path, parse.Range)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int(interval.Min), int(interval.Max), nil
+}
+
+// Part1 returns the number of passwords in the range with two adjacent digits
+// the same and digits that never decrease
+func Part1(rmin, rmax int) int {
+	return int(Part1Policy.Count(int64(rmin), int64(rmax)))
+}
+
+// Part2 returns the number of passwords in the range with digits that never
+// decrease and two adjacent digits the same that are not part of a larger
+// group
+func Part2(rmin, rmax int) int {
+	return int(Part2Policy.Count(int64(rmin), int64(rmax)))
+}
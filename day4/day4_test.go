@@ -0,0 +1,123 @@
+package day4
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPart2(t *testing.T) {
+	if Part2(445555, 445555) != 1 {
+		t.Errorf("Incorrect algorithm!")
+	}
+	if Part2(223344, 223344) != 1 {
+		t.Errorf("Incorrect algorithm!")
+	}
+	if Part2(136999, 136999) != 0 {
+		t.Errorf("Incorrect algorithm!")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		password int64
+		policy   Policy
+		broken   string
+	}{
+		{111111, Part1Policy, ""},
+		{223450, Part1Policy, "digits never decrease"},
+		{123789, Part1Policy, "some digit repeats at least 2 times in a row"},
+		{112233, Part2Policy, ""},
+		{123444, Part2Policy, "some digit repeats exactly 2 times in a row"},
+		{111122, Part2Policy, ""},
+		{11223, Part2Policy, "6 digits"},
+		{112233, Policy{Digits(1, 2), RunsOfAtMost(2)}, "only the digits 1, 2"},
+		{1122, Policy{Digits(1, 2), RunsOfAtMost(2)}, ""},
+		{11122, Policy{Digits(1, 2), RunsOfAtMost(2)}, "no digit repeats more than 2 times in a row"},
+		{1210, Policy{Digits(-1, 1, 2, 10)}, "only the digits 1, 2"},
+		{1357, Policy{Custom("odd digits", func(digits []int) bool {
+			for _, d := range digits {
+				if d%2 == 0 {
+					return false
+				}
+			}
+			return true
+		})}, ""},
+	}
+
+	for _, test := range tests {
+		err := test.policy.Validate(test.password)
+		switch {
+		case test.broken == "" && err != nil:
+			t.Errorf("%d: expected no error got %v", test.password, err)
+		case test.broken != "":
+			v, ok := err.(*Violation)
+			if !ok || v.Rule.Name != test.broken {
+				t.Errorf("%d: expected to break %q got %v", test.password, test.broken, err)
+			}
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	policies := []Policy{Part1Policy, Part2Policy, {RunsOfAtMost(2), Digits(0, 1, 3)}, {Length(3), Length(4)}}
+	for i, p := range policies {
+		var expected []int64
+		for password := int64(0); password <= 200_000; password++ {
+			if p.Valid(password) {
+				expected = append(expected, password)
+			}
+		}
+		if count := p.Count(0, 200_000); count != int64(len(expected)) {
+			t.Errorf("policy %d: expected %d passwords got %d", i, len(expected), count)
+		}
+		var listed []int64
+		p.Each(0, 200_000, func(password int64) bool {
+			listed = append(listed, password)
+			return true
+		})
+		if !reflect.DeepEqual(listed, expected) {
+			t.Errorf("policy %d: expected passwords %v got %v", i, expected, listed)
+		}
+	}
+
+	// the non decreasing numbers up to 18 digits are the ways to pick up to 18
+	// digits from 1 to 9, and zero
+	if count := (Policy{NonDecreasing()}).Count(0, 999_999_999_999_999_999); count != 4_686_825 {
+		t.Errorf("Expected 4686825 non decreasing numbers got %d", count)
+	}
+
+	long := Policy{NonDecreasing(), RunOfExactly(2)}
+	var first []int64
+	long.Each(123_456_789_000_000_000, 999_999_999_999_999_999, func(password int64) bool {
+		first = append(first, password)
+		return len(first) < 3
+	})
+	expected := []int64{123_456_888_888_888_899, 123_456_889_999_999_999, 123_457_777_777_777_788}
+	if !reflect.DeepEqual(first, expected) {
+		t.Errorf("Expected passwords %v got %v", expected, first)
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		rmin, rmax, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part1(rmin, rmax)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		rmin, rmax, err := ReadInput("input/part1.txt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+		Part2(rmin, rmax)
+	}
+}
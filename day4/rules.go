@@ -0,0 +1,231 @@
+package day4
+
+import (
+	"fmt"
+	"strings"
+)
+
+// the kinds of rules, known to the counter
+type kind int
+
+const (
+	lengthRule kind = iota
+	nonDecreasingRule
+	someRunRule
+	everyRunRule
+	digitsRule
+	customRule
+)
+
+// Rule is a condition the digits of a password must meet, rules are values
+// put together into a policy
+type Rule struct {
+	Name string
+	kind kind
+	// the number of digits of a length rule
+	n int
+	// the lengths of the runs of equal digits allowed by a run rule
+	run func(length int) bool
+	// the digits allowed by a digits rule
+	digits [10]bool
+	check  func(digits []int) bool
+}
+
+// Length requires the password to have exactly n digits
+func Length(n int) Rule {
+	return Rule{Name: fmt.Sprintf("%d digits", n), kind: lengthRule, n: n}
+}
+
+// NonDecreasing requires the digits to never decrease from left to right
+func NonDecreasing() Rule {
+	return Rule{Name: "digits never decrease", kind: nonDecreasingRule}
+}
+
+// SomeRun requires some run of equal digits next to each other to have a
+// length allowed by ok, a single digit being a run of length 1
+func SomeRun(name string, ok func(length int) bool) Rule {
+	return Rule{Name: name, kind: someRunRule, run: ok}
+}
+
+// EveryRun requires every run of equal digits to have a length allowed by ok
+func EveryRun(name string, ok func(length int) bool) Rule {
+	return Rule{Name: name, kind: everyRunRule, run: ok}
+}
+
+// RunOfAtLeast requires some digit to repeat at least n times in a row
+func RunOfAtLeast(n int) Rule {
+	return SomeRun(fmt.Sprintf("some digit repeats at least %d times in a row", n), func(length int) bool { return length >= n })
+}
+
+// RunOfExactly requires some digit to repeat exactly n times in a row
+func RunOfExactly(n int) Rule {
+	return SomeRun(fmt.Sprintf("some digit repeats exactly %d times in a row", n), func(length int) bool { return length == n })
+}
+
+// RunsOfAtMost requires no digit to repeat more than n times in a row
+func RunsOfAtMost(n int) Rule {
+	return EveryRun(fmt.Sprintf("no digit repeats more than %d times in a row", n), func(length int) bool { return length <= n })
+}
+
+// Digits only allows the given digits, values other than 0 to 9 are not
+// digits and are left out
+func Digits(allowed ...int) Rule {
+	r := Rule{kind: digitsRule}
+	var names []string
+	for _, d := range allowed {
+		if d < 0 || d > 9 {
+			continue
+		}
+		r.digits[d] = true
+		names = append(names, fmt.Sprint(d))
+	}
+	r.Name = "only the digits " + strings.Join(names, ", ")
+
+	return r
+}
+
+// Custom is a rule checking the digits with any function
+func Custom(name string, check func(digits []int) bool) Rule {
+	return Rule{Name: name, kind: customRule, check: check}
+}
+
+// Check reports whether the digits meet the rule
+func (r Rule) Check(digits []int) bool {
+	switch r.kind {
+	case lengthRule:
+		return len(digits) == r.n
+	case nonDecreasingRule:
+		for i := 1; i < len(digits); i++ {
+			if digits[i] < digits[i-1] {
+				return false
+			}
+		}
+		return true
+	case someRunRule, everyRunRule:
+		for _, length := range runs(digits) {
+			if r.run(length) == (r.kind == someRunRule) {
+				return r.kind == someRunRule
+			}
+		}
+		return r.kind == everyRunRule
+	case digitsRule:
+		for _, d := range digits {
+			if !r.digits[d] {
+				return false
+			}
+		}
+		return true
+	default:
+		return r.check(digits)
+	}
+}
+
+func (r Rule) String() string {
+	return r.Name
+}
+
+// runs returns the lengths of the runs of equal digits, from left to right
+func runs(digits []int) []int {
+	var lengths []int
+	for i := range digits {
+		if i > 0 && digits[i] == digits[i-1] {
+			lengths[len(lengths)-1]++
+		} else {
+			lengths = append(lengths, 1)
+		}
+	}
+
+	return lengths
+}
+
+// digitsOf returns the decimal digits of a non negative number
+func digitsOf(number int64) []int {
+	if number == 0 {
+		return []int{0}
+	}
+	var digits []int
+	for ; number > 0; number /= 10 {
+		digits = append(digits, int(number%10))
+	}
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+
+	return digits
+}
+
+// Policy is the set of rules every password must meet
+type Policy []Rule
+
+// the policies of the two parts of the puzzle
+var (
+	Part1Policy = Policy{Length(6), NonDecreasing(), RunOfAtLeast(2)}
+	Part2Policy = Policy{Length(6), NonDecreasing(), RunOfExactly(2)}
+)
+
+// Violation explains why a password was refused
+type Violation struct {
+	Password int64
+	Rule     Rule
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("password %d breaks the rule: %s", v.Password, v.Rule)
+}
+
+// Validate returns a *Violation for the first rule the password breaks, nil
+// if it meets them all
+func (p Policy) Validate(password int64) error {
+	if password < 0 {
+		return fmt.Errorf("password %d is negative", password)
+	}
+	digits := digitsOf(password)
+	for _, r := range p {
+		if !r.Check(digits) {
+			return &Violation{Password: password, Rule: r}
+		}
+	}
+
+	return nil
+}
+
+// Valid reports whether the password meets all the rules
+func (p Policy) Valid(password int64) bool {
+	return p.Validate(password) == nil
+}
+
+// Count returns the number of passwords from min to max, both included,
+// meeting all the rules. Policies made only of the rules of this package are
+// counted digit by digit, without going through the numbers of the range
+func (p Policy) Count(min, max int64) int64 {
+	if c, ok := newCounter(p); ok {
+		return c.walk(min, max, nil)
+	}
+
+	var count int64
+	p.Each(min, max, func(int64) bool {
+		count++
+		return true
+	})
+
+	return count
+}
+
+// Each calls fn for the passwords from min to max meeting all the rules, in
+// increasing order, until it returns false. Policies made only of the rules of
+// this package skip the invalid numbers without checking them one by one
+func (p Policy) Each(min, max int64, fn func(password int64) bool) {
+	if c, ok := newCounter(p); ok {
+		c.walk(min, max, fn)
+		return
+	}
+
+	if min < 0 {
+		min = 0
+	}
+	for password := min; password <= max && password >= 0; password++ {
+		if p.Valid(password) && !fn(password) {
+			return
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package day6
+
+import (
+	"errors"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/orbit"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	m, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(m) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} {
+			var transfers int
+			transfers, err = Part2(m)
+			return transfers
+		}),
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (*orbit.Map, error) {
+	orbits, err := parse.File(path, parse.Orbits)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := orbit.New(orbits)
+	// report bad orbits at their line, every orbit is on its own line
+	var e *orbit.Error
+	if errors.As(err, &e) && e.Orbit >= 0 {
+		return nil, &parse.Error{File: path, Line: e.Orbit + 1, Msg: e.Msg}
+	}
+
+	return m, err
+}
+
+// Part1 returns the number of direct and indirect orbits
+func Part1(m *orbit.Map) int {
+	return m.Orbits()
+}
+
+// Part2 returns the number of orbital transfers to move from the object YOU
+// orbit to the object Santa orbits
+func Part2(m *orbit.Map) (int, error) {
+	return m.Transfers("YOU", "SAN")
+}
@@ -0,0 +1,58 @@
+package day6
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/orbit"
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+const example = "COM)B\nB)C\nC)D\nD)E\nE)F\nB)G\nG)H\nD)I\nE)J\nJ)K\nK)L"
+
+func readExample(t *testing.T, text string) *orbit.Map {
+	orbits, err := parse.Orbits("example", strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := orbit.New(orbits)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+func TestExample(t *testing.T) {
+	if orbits := Part1(readExample(t, example)); orbits != 42 {
+		t.Errorf("Expected 42 orbits got %d", orbits)
+	}
+	transfers, err := Part2(readExample(t, example+"\nK)YOU\nI)SAN"))
+	if err != nil || transfers != 4 {
+		t.Errorf("Expected 4 transfers got %d, %v", transfers, err)
+	}
+}
+
+// the parts take microseconds, far less than reading the input, which is
+// read only once
+func BenchmarkPart1(b *testing.B) {
+	m, err := ReadInput("input/part1.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Part1(m)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	m, err := ReadInput("input/part1.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Part2(m)
+	}
+}
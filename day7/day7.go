@@ -1,55 +1,44 @@
-package main
+package day7
 
 import (
-	"bufio"
 	"fmt"
-	"log"
 	"math"
-	"os"
-	"strconv"
-	"strings"
-)
 
-func main() {
-	file, err := os.Open("input/part1.txt")
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/parse"
+)
 
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	program, err := ReadInput(path)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var inputs []string
-	for scanner.Scan() {
-		inputs = append(inputs, scanner.Text())
+		return nil, err
 	}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal(err)
-	}
+	return []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(program) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} { return Part2(program) }),
+	}, nil
+}
 
-	// we have just one line, with elements separated by commas
-	if len(inputs) != 1 {
-		panic("The input should be only one line long!")
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) ([]int, error) {
+	program, err := parse.File(path, parse.Intcode)
+	if err != nil {
+		return nil, err
 	}
 
-	var program []int
-	for _, integer := range strings.Split(inputs[0], ",") {
-		if val, err := strconv.Atoi(integer); err != nil {
-			panic(err)
-		} else {
-			program = append(program, val)
-		}
-
+	ints := make([]int, len(program))
+	for i, v := range program {
+		ints[i] = int(v)
 	}
-	fmt.Println("The result to 1st part is: ", part1(program))
 
-	// part 2 solution
-	fmt.Println("The result to 2nd part is: ", part2(program))
+	return ints, nil
 }
 
-func part1(input []int) int {
+func Part1(input []int) int {
 	max := math.MinInt32
 	output := 0
 	for i := 0; i < 5; i++ {
@@ -126,7 +115,7 @@ func part1(input []int) int {
 	return max
 }
 
-func part2(input []int) int {
+func Part2(input []int) int {
 	max := math.MinInt32
 	output := 0
 	for i := 5; i < 10; i++ {
@@ -0,0 +1,81 @@
+package day8
+
+import (
+	"errors"
+
+	"github.com/stanciua/adventofcode2019/aoc"
+	"github.com/stanciua/adventofcode2019/ocr"
+	"github.com/stanciua/adventofcode2019/parse"
+	"github.com/stanciua/adventofcode2019/sif"
+)
+
+// the size of the layers of the password image
+const (
+	width  = 25
+	height = 6
+)
+
+// Solve reads the puzzle input from the given file and solves both parts
+func Solve(path string) ([]aoc.Result, error) {
+	img, err := ReadInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []aoc.Result{
+		// part 1 solution
+		aoc.Measure(1, func() interface{} { return Part1(img) }),
+		// part 2 solution
+		aoc.Measure(2, func() interface{} {
+			var password string
+			password, err = Part2(img)
+			return password
+		}),
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ReadInput reads the puzzle input from the given file
+func ReadInput(path string) (*sif.Image, error) {
+	line, err := parse.File(path, parse.Single)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := sif.Decode([]byte(line.Text), width, height)
+	// report bad digits at their place in the file
+	var e *sif.Error
+	if errors.As(err, &e) {
+		return nil, line.Errorf(e.Offset+1, "%s", e.Msg)
+	}
+
+	return img, err
+}
+
+// Part1 returns the checksum of the image, the number of 1 digits times the
+// number of 2 digits of the layer with the fewest 0 digits
+func Part1(img *sif.Image) int {
+	return img.Checksum()
+}
+
+// read the password drawn with the white pixels of the decoded image
+func readPassword(password sif.Layer) (string, error) {
+	pixels := make([][]bool, password.Height)
+	for y := range pixels {
+		pixels[y] = make([]bool, password.Width)
+		for x := range pixels[y] {
+			pixels[y][x] = password.At(x, y) == sif.White
+		}
+	}
+
+	return ocr.Recognize(pixels)
+}
+
+// Part2 returns the password drawn by the decoded image
+func Part2(img *sif.Image) (string, error) {
+	return readPassword(img.Composite())
+}
@@ -0,0 +1,31 @@
+package day8
+
+import (
+	"path/filepath"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+	"github.com/stanciua/adventofcode2019/sif"
+)
+
+// Draw saves the decoded image into dir, white pixels in white over black
+func Draw(path, dir string, scale int) error {
+	img, err := ReadInput(path)
+	if err != nil {
+		return err
+	}
+
+	password := img.Composite()
+	rows := make([]string, password.Height)
+	for y := range rows {
+		row := make([]rune, password.Width)
+		for x := range row {
+			row[x] = '.'
+			if password.At(x, y) == sif.White {
+				row[x] = '#'
+			}
+		}
+		rows[y] = string(row)
+	}
+
+	return imaging.SavePNG(filepath.Join(dir, "day8-password.png"), rows, imaging.Mono, scale)
+}
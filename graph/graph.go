@@ -0,0 +1,64 @@
+// Package graph finds the shortest paths through the graphs the puzzles are
+// made of. Graphs are never built explicitly, the searches only need to know
+// how to reach the neighbors of a node.
+package graph
+
+// Edge leads to a node reachable in one step, at the given cost
+type Edge[N comparable] struct {
+	To   N
+	Cost int
+}
+
+// Paths holds the shortest paths found by a search from its source to every
+// node it reached
+type Paths[N comparable] struct {
+	source N
+	dist   map[N]int
+	prev   map[N]N
+	target N
+	found  bool
+}
+
+func newPaths[N comparable](source N) *Paths[N] {
+	return &Paths[N]{source: source, dist: map[N]int{source: 0}, prev: make(map[N]N)}
+}
+
+// Dist returns the length of the shortest path to the node and whether the
+// node was reached at all
+func (p *Paths[N]) Dist(n N) (int, bool) {
+	d, ok := p.dist[n]
+	return d, ok
+}
+
+// Found returns the node the search stopped at, if it stopped before
+// exploring the whole graph
+func (p *Paths[N]) Found() (N, bool) {
+	return p.target, p.found
+}
+
+// Each calls fn for every node reached with the length of its shortest path,
+// in no particular order
+func (p *Paths[N]) Each(fn func(n N, dist int)) {
+	for n, d := range p.dist {
+		fn(n, d)
+	}
+}
+
+// PathTo returns the nodes of the shortest path from the source to the given
+// node, both included, or nil if the node was not reached
+func (p *Paths[N]) PathTo(n N) []N {
+	if _, ok := p.dist[n]; !ok {
+		return nil
+	}
+
+	path := []N{n}
+	for n != p.source {
+		n = p.prev[n]
+		path = append(path, n)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
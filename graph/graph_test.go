@@ -0,0 +1,184 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+var maze = func() *grid.Dense[rune] {
+	g, err := grid.ParseLines([]string{
+		"#########",
+		"#S..#...#",
+		"#.#.#.#.#",
+		"#.#...#E#",
+		"#########",
+	})
+	if err != nil {
+		panic(err)
+	}
+	return g
+}()
+
+func open(p grid.Point) []grid.Point {
+	var neighbors []grid.Point
+	for _, n := range maze.Neighbors4(p) {
+		if maze.At(n) != '#' {
+			neighbors = append(neighbors, n)
+		}
+	}
+	return neighbors
+}
+
+func weighted(p grid.Point) []Edge[grid.Point] {
+	var edges []Edge[grid.Point]
+	for _, n := range open(p) {
+		edges = append(edges, Edge[grid.Point]{To: n, Cost: 1})
+	}
+	return edges
+}
+
+var (
+	start = grid.Point{X: 1, Y: 1}
+	end   = grid.Point{X: 7, Y: 3}
+)
+
+func TestSearches(t *testing.T) {
+	searches := map[string]*Paths[grid.Point]{
+		"BFS":      BFS(start, open, func(p grid.Point) bool { return p == end }),
+		"Dijkstra": Dijkstra(start, weighted, func(p grid.Point) bool { return p == end }),
+		"AStar":    AStar(start, end, weighted, Manhattan(end)),
+	}
+
+	for name, paths := range searches {
+		if d, ok := paths.Dist(end); !ok || d != 12 {
+			t.Errorf("%s: expected distance 12 got %d", name, d)
+		}
+		if found, ok := paths.Found(); !ok || found != end {
+			t.Errorf("%s: expected to stop at %v got %v", name, end, found)
+		}
+		path := paths.PathTo(end)
+		if len(path) != 13 || path[0] != start || path[12] != end {
+			t.Errorf("%s: unexpected path %v", name, path)
+		}
+		for i := 1; i < len(path); i++ {
+			if path[i].Manhattan(path[i-1]) != 1 {
+				t.Errorf("%s: path is not continuous at %v", name, path[i])
+			}
+		}
+	}
+}
+
+func TestDijkstraWeighted(t *testing.T) {
+	// the direct edge is more expensive than going around
+	edges := map[string][]Edge[string]{
+		"a": {{"c", 10}, {"b", 1}},
+		"b": {{"c", 2}},
+	}
+	paths := Dijkstra("a", func(n string) []Edge[string] { return edges[n] }, nil)
+
+	if d, _ := paths.Dist("c"); d != 3 {
+		t.Errorf("Expected distance 3 got %d", d)
+	}
+	if path := paths.PathTo("c"); !reflect.DeepEqual([]string{"a", "b", "c"}, path) {
+		t.Errorf("Unexpected path %v", path)
+	}
+	if _, ok := paths.Dist("d"); ok || paths.PathTo("d") != nil {
+		t.Errorf("Node d should not be reached")
+	}
+}
+
+// an open grid, every search has to explore most of it
+var field = grid.NewDense(200, 200, '.')
+
+func fieldNeighbors(p grid.Point) []Edge[grid.Point] {
+	var edges []Edge[grid.Point]
+	for _, n := range field.Neighbors4(p) {
+		edges = append(edges, Edge[grid.Point]{To: n, Cost: 1})
+	}
+	return edges
+}
+
+func BenchmarkDijkstra(b *testing.B) {
+	target := grid.Point{X: 199, Y: 199}
+	for i := 0; i < b.N; i++ {
+		Dijkstra(grid.Point{}, fieldNeighbors, func(p grid.Point) bool { return p == target })
+	}
+}
+
+func BenchmarkAStar(b *testing.B) {
+	target := grid.Point{X: 199, Y: 199}
+	for i := 0; i < b.N; i++ {
+		AStar(grid.Point{}, target, fieldNeighbors, Manhattan(target))
+	}
+}
+
+func BenchmarkBFS(b *testing.B) {
+	target := grid.Point{X: 199, Y: 199}
+	for i := 0; i < b.N; i++ {
+		BFS(grid.Point{}, field.Neighbors4, func(p grid.Point) bool { return p == target })
+	}
+}
+
+// the same search on a smaller field, against a Dijkstra scanning every queued
+// node for the closest one like the searches of the days did
+var small = grid.NewDense(60, 60, '.')
+
+func smallNeighbors(p grid.Point) []Edge[grid.Point] {
+	var edges []Edge[grid.Point]
+	for _, n := range small.Neighbors4(p) {
+		edges = append(edges, Edge[grid.Point]{To: n, Cost: 1})
+	}
+	return edges
+}
+
+func quadraticDijkstra(source, target grid.Point, neighbors func(grid.Point) []Edge[grid.Point]) int {
+	dist := map[grid.Point]int{source: 0}
+	queue := []grid.Point{source}
+	for len(queue) > 0 {
+		index := 0
+		for i, n := range queue {
+			if dist[n] < dist[queue[index]] {
+				index = i
+			}
+		}
+		u := queue[index]
+		if u == target {
+			return dist[u]
+		}
+		queue = append(queue[:index], queue[index+1:]...)
+		for _, e := range neighbors(u) {
+			if d, ok := dist[e.To]; !ok || dist[u]+e.Cost < d {
+				if !ok {
+					queue = append(queue, e.To)
+				}
+				dist[e.To] = dist[u] + e.Cost
+			}
+		}
+	}
+
+	return -1
+}
+
+func TestQuadraticDijkstra(t *testing.T) {
+	target := grid.Point{X: 59, Y: 59}
+	want, _ := Dijkstra(grid.Point{}, smallNeighbors, func(p grid.Point) bool { return p == target }).Dist(target)
+	if got := quadraticDijkstra(grid.Point{}, target, smallNeighbors); got != want {
+		t.Errorf("Expected %d got %d", want, got)
+	}
+}
+
+func BenchmarkDijkstraSmall(b *testing.B) {
+	target := grid.Point{X: 59, Y: 59}
+	for i := 0; i < b.N; i++ {
+		Dijkstra(grid.Point{}, smallNeighbors, func(p grid.Point) bool { return p == target })
+	}
+}
+
+func BenchmarkDijkstraQuadratic(b *testing.B) {
+	target := grid.Point{X: 59, Y: 59}
+	for i := 0; i < b.N; i++ {
+		quadraticDijkstra(grid.Point{}, target, smallNeighbors)
+	}
+}
@@ -0,0 +1,18 @@
+package graph
+
+import (
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+// Manhattan estimates the distance to the target on a grid where every step
+// costs 1 and moves to one of the 4 neighbors
+func Manhattan(target grid.Point) Heuristic[grid.Point] {
+	return func(p grid.Point) int {
+		return p.Manhattan(target)
+	}
+}
+
+// Zero turns A* into Dijkstra's algorithm
+func Zero[N comparable](N) int {
+	return 0
+}
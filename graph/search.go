@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"container/heap"
+)
+
+// BFS explores an unweighted graph breadth first from the source, stopping as
+// soon as it reaches a node for which done returns true; with a nil done the
+// whole reachable graph is explored
+func BFS[N comparable](source N, neighbors func(n N) []N, done func(n N) bool) *Paths[N] {
+	paths := newPaths(source)
+	queue := []N{source}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if done != nil && done(n) {
+			paths.target, paths.found = n, true
+			return paths
+		}
+
+		for _, next := range neighbors(n) {
+			if _, seen := paths.dist[next]; seen {
+				continue
+			}
+			paths.dist[next] = paths.dist[n] + 1
+			paths.prev[next] = n
+			queue = append(queue, next)
+		}
+	}
+
+	return paths
+}
+
+// Dijkstra explores a graph with non negative costs from the source in order
+// of distance, stopping as soon as it settles a node for which done returns
+// true; with a nil done the whole reachable graph is explored
+func Dijkstra[N comparable](source N, neighbors func(n N) []Edge[N], done func(n N) bool) *Paths[N] {
+	return search(source, neighbors, done, nil)
+}
+
+// Heuristic estimates the cost of the path from a node to the target, it must
+// never overestimate it for the search to find the shortest path
+type Heuristic[N comparable] func(n N) int
+
+// AStar finds the shortest path from the source to the target, exploring
+// first the nodes that look closer to the target according to the heuristic
+func AStar[N comparable](source, target N, neighbors func(n N) []Edge[N], h Heuristic[N]) *Paths[N] {
+	return search(source, neighbors, func(n N) bool { return n == target }, h)
+}
+
+func search[N comparable](source N, neighbors func(n N) []Edge[N], done func(n N) bool, h Heuristic[N]) *Paths[N] {
+	paths := newPaths(source)
+	estimate := func(n N) int {
+		if h == nil {
+			return paths.dist[n]
+		}
+		return paths.dist[n] + h(n)
+	}
+
+	queue := &priorityQueue[N]{}
+	heap.Push(queue, item[N]{node: source, dist: 0, priority: estimate(source)})
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(item[N])
+		// the node was queued again with a shorter distance, this entry is stale
+		if current.dist > paths.dist[current.node] {
+			continue
+		}
+		if done != nil && done(current.node) {
+			paths.target, paths.found = current.node, true
+			return paths
+		}
+
+		for _, e := range neighbors(current.node) {
+			alt := current.dist + e.Cost
+			if d, seen := paths.dist[e.To]; seen && d <= alt {
+				continue
+			}
+			paths.dist[e.To] = alt
+			paths.prev[e.To] = current.node
+			heap.Push(queue, item[N]{node: e.To, dist: alt, priority: estimate(e.To)})
+		}
+	}
+
+	return paths
+}
+
+type item[N comparable] struct {
+	node     N
+	dist     int
+	priority int
+}
+
+// priorityQueue pops the items with the lowest priority first
+type priorityQueue[N comparable] []item[N]
+
+func (q priorityQueue[N]) Len() int           { return len(q) }
+func (q priorityQueue[N]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q priorityQueue[N]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *priorityQueue[N]) Push(x interface{}) {
+	*q = append(*q, x.(item[N]))
+}
+
+func (q *priorityQueue[N]) Pop() interface{} {
+	old := *q
+	n := len(old)
+	it := old[n-1]
+	*q = old[:n-1]
+	return it
+}
@@ -0,0 +1,153 @@
+package grid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dense is a grid of fixed size with a value for every cell, its top left
+// cell is at the origin
+type Dense[T any] struct {
+	width  int
+	height int
+	cells  []T
+}
+
+// NewDense returns a grid of the given size with every cell set to fill
+func NewDense[T any](width, height int, fill T) *Dense[T] {
+	g := &Dense[T]{width: width, height: height, cells: make([]T, width*height)}
+	for i := range g.cells {
+		g.cells[i] = fill
+	}
+
+	return g
+}
+
+// Parse returns the grid of the given rows, failing when they are not all of
+// the same length
+func Parse(rows [][]rune) (*Dense[rune], error) {
+	width := 0
+	if len(rows) > 0 {
+		width = len(rows[0])
+	}
+	g := &Dense[rune]{width: width, height: len(rows), cells: make([]rune, 0, width*len(rows))}
+	for i, row := range rows {
+		if len(row) != width {
+			return nil, fmt.Errorf("grid row %d has %d cells, expected %d like the first row", i, len(row), width)
+		}
+		g.cells = append(g.cells, row...)
+	}
+
+	return g, nil
+}
+
+// ParseLines returns the grid of the given lines of text, see Parse
+func ParseLines(lines []string) (*Dense[rune], error) {
+	rows := make([][]rune, len(lines))
+	for i, line := range lines {
+		rows[i] = []rune(line)
+	}
+
+	return Parse(rows)
+}
+
+// Width returns the number of columns of the grid
+func (g *Dense[T]) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows of the grid
+func (g *Dense[T]) Height() int {
+	return g.height
+}
+
+// Bounds returns the rectangle covered by the grid
+func (g *Dense[T]) Bounds() Rect {
+	return Rect{Max: Point{X: g.width - 1, Y: g.height - 1}}
+}
+
+// In reports whether the point is inside the grid
+func (g *Dense[T]) In(p Point) bool {
+	return p.X >= 0 && p.X < g.width && p.Y >= 0 && p.Y < g.height
+}
+
+// At returns the value of the cell at the given point, which must be inside
+// the grid
+func (g *Dense[T]) At(p Point) T {
+	return g.cells[p.Y*g.width+p.X]
+}
+
+// Set changes the value of the cell at the given point, which must be inside
+// the grid
+func (g *Dense[T]) Set(p Point, v T) {
+	g.cells[p.Y*g.width+p.X] = v
+}
+
+// Row returns the cells of the given row, changing them changes the grid
+func (g *Dense[T]) Row(y int) []T {
+	return g.cells[y*g.width : (y+1)*g.width]
+}
+
+// Neighbors4 returns the points inside the grid sharing an edge with p
+func (g *Dense[T]) Neighbors4(p Point) []Point {
+	return g.inside(p.Neighbors4())
+}
+
+// Neighbors8 returns the points inside the grid sharing an edge or a corner
+// with p
+func (g *Dense[T]) Neighbors8(p Point) []Point {
+	return g.inside(p.Neighbors8())
+}
+
+func (g *Dense[T]) inside(points []Point) []Point {
+	n := 0
+	for _, p := range points {
+		if g.In(p) {
+			points[n] = p
+			n++
+		}
+	}
+
+	return points[:n]
+}
+
+// Each calls fn for every cell of the grid, row by row
+func (g *Dense[T]) Each(fn func(p Point, v T)) {
+	for i, v := range g.cells {
+		fn(Point{X: i % g.width, Y: i / g.width}, v)
+	}
+}
+
+// Find returns the points of the cells matching the predicate, row by row
+func (g *Dense[T]) Find(match func(v T) bool) []Point {
+	var points []Point
+	g.Each(func(p Point, v T) {
+		if match(v) {
+			points = append(points, p)
+		}
+	})
+
+	return points
+}
+
+// Clone returns a copy of the grid
+func (g *Dense[T]) Clone() *Dense[T] {
+	clone := &Dense[T]{width: g.width, height: g.height, cells: make([]T, len(g.cells))}
+	copy(clone.cells, g.cells)
+
+	return clone
+}
+
+// Render draws the grid as lines of text, using symbol to draw every cell
+func (g *Dense[T]) Render(symbol func(v T) rune) []string {
+	lines := make([]string, g.height)
+	for y := range lines {
+		var line strings.Builder
+		for _, v := range g.Row(y) {
+			line.WriteRune(symbol(v))
+		}
+		lines[y] = line.String()
+	}
+
+	return lines
+}
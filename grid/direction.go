@@ -0,0 +1,78 @@
+package grid
+
+// Direction is one of the four directions a point can move in, they are
+// ordered clockwise so turning is a matter of counting
+type Direction int
+
+const (
+	Up Direction = iota
+	Right
+	Down
+	Left
+)
+
+// Directions lists the four directions clockwise, starting with Up
+var Directions = [4]Direction{Up, Right, Down, Left}
+
+// TurnLeft returns the direction after a 90 degree counterclockwise turn
+func (d Direction) TurnLeft() Direction {
+	return (d + 3) % 4
+}
+
+// TurnRight returns the direction after a 90 degree clockwise turn
+func (d Direction) TurnRight() Direction {
+	return (d + 1) % 4
+}
+
+// Reverse returns the opposite direction
+func (d Direction) Reverse() Direction {
+	return (d + 2) % 4
+}
+
+// Offset returns the offset of a single step in this direction
+func (d Direction) Offset() Point {
+	return offsets[d]
+}
+
+func (d Direction) String() string {
+	switch d {
+	case Up:
+		return "U"
+	case Right:
+		return "R"
+	case Down:
+		return "D"
+	case Left:
+		return "L"
+	}
+
+	return "?"
+}
+
+// DirectionOf returns the direction of a single step offset
+func DirectionOf(offset Point) (Direction, bool) {
+	for _, d := range Directions {
+		if d.Offset() == offset {
+			return d, true
+		}
+	}
+
+	return 0, false
+}
+
+// ParseDirection reads a direction written either as a letter (U, R, D, L)
+// or as an arrow (^, >, v, <)
+func ParseDirection(r rune) (Direction, bool) {
+	switch r {
+	case 'U', '^':
+		return Up, true
+	case 'R', '>':
+		return Right, true
+	case 'D', 'v':
+		return Down, true
+	case 'L', '<':
+		return Left, true
+	}
+
+	return 0, false
+}
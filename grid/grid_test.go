@@ -0,0 +1,71 @@
+package grid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTurns(t *testing.T) {
+	for _, d := range Directions {
+		if d.TurnLeft().TurnRight() != d {
+			t.Errorf("Turning left then right should keep %v", d)
+		}
+		if d.TurnRight().TurnRight() != d.Reverse() {
+			t.Errorf("Turning right twice should reverse %v", d)
+		}
+		if back, ok := DirectionOf(d.Reverse().Offset()); !ok || back != d.Reverse() {
+			t.Errorf("Expected %v got %v", d.Reverse(), back)
+		}
+	}
+
+	if p := (Point{}).Move(Up).Move(Right); p != (Point{X: 1, Y: -1}) {
+		t.Errorf("Expected {1 -1} got %v", p)
+	}
+}
+
+func TestDenseNeighbors(t *testing.T) {
+	g, err := ParseLines([]string{
+		"#..",
+		".#.",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseLines([]string{"#..", ".#"}); err == nil {
+		t.Errorf("Expected an error for rows of different lengths")
+	}
+
+	expected := []Point{{1, 0}, {0, 1}}
+	if n := g.Neighbors4(Point{}); !reflect.DeepEqual(expected, n) {
+		t.Errorf("Expected %v got %v", expected, n)
+	}
+	if n := g.Neighbors8(Point{X: 1, Y: 1}); len(n) != 5 {
+		t.Errorf("Expected 5 neighbors got %v", n)
+	}
+
+	lit := g.Find(func(c rune) bool { return c == '#' })
+	if !reflect.DeepEqual([]Point{{0, 0}, {1, 1}}, lit) {
+		t.Errorf("Unexpected cells found %v", lit)
+	}
+}
+
+func TestSparseRender(t *testing.T) {
+	g := NewSparse[bool]()
+	g.Set(Point{X: -1, Y: 2}, true)
+	g.Set(Point{X: 1, Y: 3}, true)
+
+	if b := g.Bounds(); b != (Rect{Min: Point{-1, 2}, Max: Point{1, 3}}) {
+		t.Errorf("Unexpected bounds %v", b)
+	}
+
+	expected := []string{"#..", "..#"}
+	rendered := g.Render(func(v bool) rune {
+		if v {
+			return '#'
+		}
+		return '.'
+	})
+	if !reflect.DeepEqual(expected, rendered) {
+		t.Errorf("Expected %v got %v", expected, rendered)
+	}
+}
@@ -0,0 +1,64 @@
+// Package grid holds the two dimensional grids the puzzles are played on,
+// together with the points, directions and bounds used to walk them.
+package grid
+
+// Point is a position on a grid, X grows to the right and Y grows downwards
+type Point struct {
+	X int
+	Y int
+}
+
+// Add returns the point moved by the given offset
+func (p Point) Add(offset Point) Point {
+	return Point{X: p.X + offset.X, Y: p.Y + offset.Y}
+}
+
+// Sub returns the offset between the two points
+func (p Point) Sub(q Point) Point {
+	return Point{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+// Move returns the point one step away in the given direction
+func (p Point) Move(d Direction) Point {
+	return p.Add(d.Offset())
+}
+
+// Manhattan returns the taxicab distance between the two points
+func (p Point) Manhattan(q Point) int {
+	return abs(p.X-q.X) + abs(p.Y-q.Y)
+}
+
+// offsets of the 8 surrounding points, the first 4 share an edge with the point
+var offsets = [8]Point{
+	{0, -1}, {1, 0}, {0, 1}, {-1, 0},
+	{1, -1}, {1, 1}, {-1, 1}, {-1, -1},
+}
+
+// Neighbors4 returns the points sharing an edge with this one, clockwise
+// starting from the one above
+func (p Point) Neighbors4() []Point {
+	neighbors := make([]Point, 4)
+	for i, offset := range offsets[:4] {
+		neighbors[i] = p.Add(offset)
+	}
+
+	return neighbors
+}
+
+// Neighbors8 returns the points sharing an edge or a corner with this one
+func (p Point) Neighbors8() []Point {
+	neighbors := make([]Point, 8)
+	for i, offset := range offsets {
+		neighbors[i] = p.Add(offset)
+	}
+
+	return neighbors
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
@@ -0,0 +1,51 @@
+package grid
+
+// Rect is the rectangle between two corners, both of them included
+type Rect struct {
+	Min Point
+	Max Point
+}
+
+// BoundsOf returns the smallest rectangle holding all the points, the points
+// must not be empty
+func BoundsOf(points []Point) Rect {
+	r := Rect{Min: points[0], Max: points[0]}
+	for _, p := range points[1:] {
+		r = r.Extend(p)
+	}
+
+	return r
+}
+
+// Extend returns the smallest rectangle holding both the rectangle and the point
+func (r Rect) Extend(p Point) Rect {
+	if p.X < r.Min.X {
+		r.Min.X = p.X
+	}
+	if p.Y < r.Min.Y {
+		r.Min.Y = p.Y
+	}
+	if p.X > r.Max.X {
+		r.Max.X = p.X
+	}
+	if p.Y > r.Max.Y {
+		r.Max.Y = p.Y
+	}
+
+	return r
+}
+
+// Contains reports whether the point is inside the rectangle
+func (r Rect) Contains(p Point) bool {
+	return p.X >= r.Min.X && p.X <= r.Max.X && p.Y >= r.Min.Y && p.Y <= r.Max.Y
+}
+
+// Width returns the number of columns of the rectangle
+func (r Rect) Width() int {
+	return r.Max.X - r.Min.X + 1
+}
+
+// Height returns the number of rows of the rectangle
+func (r Rect) Height() int {
+	return r.Max.Y - r.Min.Y + 1
+}
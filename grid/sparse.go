@@ -0,0 +1,75 @@
+package grid
+
+import (
+	"strings"
+)
+
+// Sparse is an unbounded grid that only stores the cells that were set, the
+// other ones hold the zero value
+type Sparse[T any] struct {
+	cells  map[Point]T
+	bounds Rect
+}
+
+// NewSparse returns an empty grid
+func NewSparse[T any]() *Sparse[T] {
+	return &Sparse[T]{cells: make(map[Point]T)}
+}
+
+// Len returns the number of cells that were set
+func (g *Sparse[T]) Len() int {
+	return len(g.cells)
+}
+
+// Bounds returns the smallest rectangle holding every cell that was set
+func (g *Sparse[T]) Bounds() Rect {
+	return g.bounds
+}
+
+// At returns the value of the cell at the given point
+func (g *Sparse[T]) At(p Point) T {
+	return g.cells[p]
+}
+
+// Get returns the value of the cell at the given point and whether it was set
+func (g *Sparse[T]) Get(p Point) (T, bool) {
+	v, ok := g.cells[p]
+	return v, ok
+}
+
+// Set changes the value of the cell at the given point, growing the bounds if
+// needed
+func (g *Sparse[T]) Set(p Point, v T) {
+	if len(g.cells) == 0 {
+		g.bounds = Rect{Min: p, Max: p}
+	} else {
+		g.bounds = g.bounds.Extend(p)
+	}
+	g.cells[p] = v
+}
+
+// Each calls fn for every cell that was set, in no particular order
+func (g *Sparse[T]) Each(fn func(p Point, v T)) {
+	for p, v := range g.cells {
+		fn(p, v)
+	}
+}
+
+// Render draws the cells inside the bounds as lines of text, using symbol to
+// draw every cell, including the ones that were never set
+func (g *Sparse[T]) Render(symbol func(v T) rune) []string {
+	if len(g.cells) == 0 {
+		return nil
+	}
+
+	lines := make([]string, g.bounds.Height())
+	for y := range lines {
+		var line strings.Builder
+		for x := g.bounds.Min.X; x <= g.bounds.Max.X; x++ {
+			line.WriteRune(symbol(g.cells[Point{X: x, Y: g.bounds.Min.Y + y}]))
+		}
+		lines[y] = line.String()
+	}
+
+	return lines
+}
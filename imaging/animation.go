@@ -0,0 +1,76 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+)
+
+// Animation collects the frames of a simulation as it runs, to be saved as an
+// animated GIF; Capture is meant to be used as the frame hook of a simulation
+type Animation struct {
+	// Delay between frames, in 100ths of a second
+	Delay  int
+	scale  int
+	colors color.Palette
+	index  map[rune]uint8
+	frames []*image.Paletted
+	// the error of a palette that cannot be drawn, returned when writing
+	err error
+}
+
+// NewAnimation returns an empty animation drawing its frames with the given
+// palette and scale; a palette with too many colors captures no frames and
+// fails to be written
+func NewAnimation(p Palette, scale int, delay int) *Animation {
+	colors, index, err := p.indexed()
+	return &Animation{Delay: delay, scale: scale, colors: colors, index: index, err: err}
+}
+
+// Capture adds a frame with the drawing of the rows
+func (a *Animation) Capture(rows []string) {
+	if a.err != nil {
+		return
+	}
+	a.frames = append(a.frames, draw(rows, a.colors, a.index, a.scale))
+}
+
+// Len returns the number of captured frames
+func (a *Animation) Len() int {
+	return len(a.frames)
+}
+
+// WriteGIF encodes the captured frames as an animated GIF that loops forever,
+// the last frame is held for a second
+func (a *Animation) WriteGIF(w io.Writer) error {
+	if a.err != nil {
+		return a.err
+	}
+	anim := &gif.GIF{}
+	for i, frame := range a.frames {
+		delay := a.Delay
+		if i == len(a.frames)-1 {
+			delay += 100
+		}
+		anim.Image = append(anim.Image, frame)
+		anim.Delay = append(anim.Delay, delay)
+
+		// frames can grow, the screen must fit the largest one
+		b := frame.Bounds()
+		if b.Dx() > anim.Config.Width {
+			anim.Config.Width = b.Dx()
+		}
+		if b.Dy() > anim.Config.Height {
+			anim.Config.Height = b.Dy()
+		}
+	}
+	anim.Config.ColorModel = a.colors
+
+	return gif.EncodeAll(w, anim)
+}
+
+// Save writes the captured frames into a GIF file
+func (a *Animation) Save(path string) error {
+	return save(path, a.WriteGIF)
+}
@@ -0,0 +1,126 @@
+// Package imaging turns the grids drawn by the puzzles as rows of symbols into
+// PNG images and animated GIFs.
+package imaging
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"sort"
+)
+
+// Palette maps the symbols of a drawing to colors, the symbols without a color
+// of their own are drawn with the background color
+type Palette struct {
+	Background color.Color
+	Colors     map[rune]color.Color
+}
+
+// Mono draws '#' in white over a black background
+var Mono = Palette{
+	Background: color.Black,
+	Colors:     map[rune]color.Color{'#': color.White},
+}
+
+// the colors of the palette in a fixed order, the background being the first
+// one, together with the index of every symbol; the indexes are bytes, so a
+// palette has room for 255 colors besides the background
+func (p Palette) indexed() (color.Palette, map[rune]uint8, error) {
+	symbols := make([]rune, 0, len(p.Colors))
+	for s := range p.Colors {
+		symbols = append(symbols, s)
+	}
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i] < symbols[j] })
+	if len(symbols) > 255 {
+		return nil, nil, fmt.Errorf("a palette holds at most 255 colors besides the background, got %d", len(symbols))
+	}
+
+	background := p.Background
+	if background == nil {
+		background = color.Black
+	}
+	colors := color.Palette{background}
+	index := make(map[rune]uint8, len(symbols))
+	for i, s := range symbols {
+		colors = append(colors, p.Colors[s])
+		index[s] = uint8(i + 1)
+	}
+
+	return colors, index, nil
+}
+
+// Draw paints the rows of symbols, every symbol becoming a square of scale
+// by scale pixels; rows shorter than the longest one are padded with the
+// background. Palettes with too many colors are an error
+func Draw(rows []string, p Palette, scale int) (*image.Paletted, error) {
+	colors, index, err := p.indexed()
+	if err != nil {
+		return nil, err
+	}
+
+	return draw(rows, colors, index, scale), nil
+}
+
+func draw(rows []string, colors color.Palette, index map[rune]uint8, scale int) *image.Paletted {
+	if scale < 1 {
+		scale = 1
+	}
+
+	width := 0
+	for _, row := range rows {
+		if n := len([]rune(row)); n > width {
+			width = n
+		}
+	}
+
+	img := image.NewPaletted(image.Rect(0, 0, width*scale, len(rows)*scale), colors)
+	for y, row := range rows {
+		for x, s := range []rune(row) {
+			i, ok := index[s]
+			if !ok {
+				continue
+			}
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					img.SetColorIndex(x*scale+dx, y*scale+dy, i)
+				}
+			}
+		}
+	}
+
+	return img
+}
+
+// WritePNG encodes the drawing of the rows as a PNG image
+func WritePNG(w io.Writer, rows []string, p Palette, scale int) error {
+	img, err := Draw(rows, p, scale)
+	if err != nil {
+		return err
+	}
+
+	return png.Encode(w, img)
+}
+
+// SavePNG writes the drawing of the rows into a PNG file
+func SavePNG(path string, rows []string, p Palette, scale int) error {
+	return save(path, func(w io.Writer) error {
+		return WritePNG(w, rows, p, scale)
+	})
+}
+
+func save(path string, write func(w io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := write(file); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
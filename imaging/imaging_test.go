@@ -0,0 +1,100 @@
+package imaging
+
+import (
+	"bytes"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+)
+
+var palette = Palette{
+	Background: color.Black,
+	Colors: map[rune]color.Color{
+		'#': color.White,
+		'o': color.RGBA{0xff, 0, 0, 0xff},
+	},
+}
+
+func TestDraw(t *testing.T) {
+	img, err := Draw([]string{"#.o", "#"}, palette, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 6 || b.Dy() != 4 {
+		t.Fatalf("expected a 6x4 image, got %dx%d", b.Dx(), b.Dy())
+	}
+
+	tests := []struct {
+		x, y int
+		want color.Color
+	}{
+		{0, 0, color.White},
+		{1, 1, color.White},
+		{2, 0, color.Black},
+		{5, 1, color.RGBA{0xff, 0, 0, 0xff}},
+		{2, 2, color.Black},
+		{1, 3, color.White},
+	}
+	for _, test := range tests {
+		r1, g1, b1, _ := img.At(test.x, test.y).RGBA()
+		r2, g2, b2, _ := test.want.RGBA()
+		if r1 != r2 || g1 != g2 || b1 != b2 {
+			t.Errorf("pixel (%d, %d): expected %v, got %v", test.x, test.y, test.want, img.At(test.x, test.y))
+		}
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WritePNG(&buf, []string{"#."}, Mono, 3); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 6 || b.Dy() != 3 {
+		t.Errorf("expected a 6x3 image, got %dx%d", b.Dx(), b.Dy())
+	}
+}
+
+func TestAnimation(t *testing.T) {
+	a := NewAnimation(palette, 1, 5)
+	a.Capture([]string{"#"})
+	a.Capture([]string{"#o", "o#"})
+	if a.Len() != 2 {
+		t.Fatalf("expected 2 frames, got %d", a.Len())
+	}
+
+	var buf bytes.Buffer
+	if err := a.WriteGIF(&buf); err != nil {
+		t.Fatal(err)
+	}
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(anim.Image) != 2 || anim.Delay[0] != 5 || anim.Delay[1] != 105 {
+		t.Errorf("unexpected frames %d with delays %v", len(anim.Image), anim.Delay)
+	}
+	if anim.Config.Width != 2 || anim.Config.Height != 2 {
+		t.Errorf("expected a 2x2 screen, got %dx%d", anim.Config.Width, anim.Config.Height)
+	}
+}
+
+func TestTooManyColors(t *testing.T) {
+	p := Palette{Colors: make(map[rune]color.Color)}
+	for i := 0; i < 256; i++ {
+		p.Colors[rune('A'+i)] = color.White
+	}
+	var buf bytes.Buffer
+	if err := WritePNG(&buf, []string{"A"}, p, 1); err == nil {
+		t.Errorf("expected an error for 256 colors besides the background")
+	}
+	a := NewAnimation(p, 1, 5)
+	a.Capture([]string{"A"})
+	if err := a.WriteGIF(&buf); err == nil {
+		t.Errorf("expected an error for 256 colors besides the background")
+	}
+}
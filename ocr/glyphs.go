@@ -0,0 +1,42 @@
+package ocr
+
+// the 4x6 letters, most of them are followed by an empty column
+var small = map[rune][]string{
+	'A': {".##.", "#..#", "#..#", "####", "#..#", "#..#"},
+	'B': {"###.", "#..#", "###.", "#..#", "#..#", "###."},
+	'C': {".##.", "#..#", "#...", "#...", "#..#", ".##."},
+	'E': {"####", "#...", "###.", "#...", "#...", "####"},
+	'F': {"####", "#...", "###.", "#...", "#...", "#..."},
+	'G': {".##.", "#..#", "#...", "#.##", "#..#", ".###"},
+	'H': {"#..#", "#..#", "####", "#..#", "#..#", "#..#"},
+	'I': {"###", ".#.", ".#.", ".#.", ".#.", "###"},
+	'J': {"..##", "...#", "...#", "...#", "#..#", ".##."},
+	'K': {"#..#", "#.#.", "##..", "#.#.", "#.#.", "#..#"},
+	'L': {"#...", "#...", "#...", "#...", "#...", "####"},
+	'O': {".##.", "#..#", "#..#", "#..#", "#..#", ".##."},
+	'P': {"###.", "#..#", "#..#", "###.", "#...", "#..."},
+	'R': {"###.", "#..#", "#..#", "###.", "#.#.", "#..#"},
+	'S': {".###", "#...", "#...", ".##.", "...#", "###."},
+	'U': {"#..#", "#..#", "#..#", "#..#", "#..#", ".##."},
+	'Y': {"#...#", "#...#", ".#.#.", "..#..", "..#..", "..#.."},
+	'Z': {"####", "...#", "..#.", ".#..", "#...", "####"},
+}
+
+// the 6x10 letters, followed by two empty columns
+var large = map[rune][]string{
+	'A': {"..##..", ".#..#.", "#....#", "#....#", "#....#", "######", "#....#", "#....#", "#....#", "#....#"},
+	'B': {"#####.", "#....#", "#....#", "#....#", "#####.", "#....#", "#....#", "#....#", "#....#", "#####."},
+	'C': {".####.", "#....#", "#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "#....#", ".####."},
+	'E': {"######", "#.....", "#.....", "#.....", "#####.", "#.....", "#.....", "#.....", "#.....", "######"},
+	'F': {"######", "#.....", "#.....", "#.....", "#####.", "#.....", "#.....", "#.....", "#.....", "#....."},
+	'G': {".####.", "#....#", "#.....", "#.....", "#.....", "#..###", "#....#", "#....#", "#...##", ".###.#"},
+	'H': {"#....#", "#....#", "#....#", "#....#", "######", "#....#", "#....#", "#....#", "#....#", "#....#"},
+	'J': {"...###", "....#.", "....#.", "....#.", "....#.", "....#.", "....#.", "#...#.", "#...#.", ".###.."},
+	'K': {"#....#", "#...#.", "#..#..", "#.#...", "##....", "##....", "#.#...", "#..#..", "#...#.", "#....#"},
+	'L': {"#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "#.....", "######"},
+	'N': {"#....#", "##...#", "##...#", "#.#..#", "#.#..#", "#..#.#", "#..#.#", "#...##", "#...##", "#....#"},
+	'P': {"#####.", "#....#", "#....#", "#....#", "#####.", "#.....", "#.....", "#.....", "#.....", "#....."},
+	'R': {"#####.", "#....#", "#....#", "#....#", "#####.", "#..#..", "#...#.", "#...#.", "#....#", "#....#"},
+	'X': {"#....#", "#....#", ".#..#.", ".#..#.", "..##..", "..##..", ".#..#.", ".#..#.", "#....#", "#....#"},
+	'Z': {"######", ".....#", ".....#", "....#.", "...#..", "..#...", ".#....", "#.....", "#.....", "######"},
+}
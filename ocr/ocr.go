@@ -0,0 +1,204 @@
+// Package ocr reads the text drawn with the block letters used by the puzzles
+// that render their answer as an image.
+package ocr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// font is an alphabet of block letters of the same height
+type font struct {
+	height int
+	// the width of a letter cell, including the spacing to the next letter
+	cellWidth int
+	// the widest letter, runs of lit columns wider than this hold more
+	// than one letter
+	maxWidth int
+	glyphs   map[string]rune
+}
+
+var fonts = []*font{
+	newFont(6, 5, small),
+	newFont(10, 8, large),
+}
+
+func newFont(height, cellWidth int, letters map[rune][]string) *font {
+	f := &font{height: height, cellWidth: cellWidth, glyphs: make(map[string]rune)}
+	for letter, rows := range letters {
+		grid := make([][]bool, len(rows))
+		for y, row := range rows {
+			grid[y] = make([]bool, len(row))
+			for x, c := range row {
+				grid[y][x] = c == '#'
+			}
+		}
+		first, last := litColumns(grid)
+		if last-first+1 > f.maxWidth {
+			f.maxWidth = last - first + 1
+		}
+		f.glyphs[glyphKey(grid, first, last)] = letter
+	}
+
+	return f
+}
+
+// Error reports the letters that could not be recognized, the text read so
+// far has a '?' in their place
+type Error struct {
+	Text    string
+	Columns []int
+}
+
+func (e *Error) Error() string {
+	columns := make([]string, len(e.Columns))
+	for i, column := range e.Columns {
+		columns[i] = fmt.Sprint(column)
+	}
+
+	return fmt.Sprintf("unrecognized letters in %q at columns %s", e.Text, strings.Join(columns, ", "))
+}
+
+// Recognize reads the text drawn in an image where true marks a lit pixel;
+// both the 4x6 and the 6x10 alphabets are known, empty rows and columns around
+// the text are ignored
+func Recognize(image [][]bool) (string, error) {
+	// drop the empty rows above and below the text
+	top, bottom := 0, len(image)-1
+	for top <= bottom && !rowLit(image[top]) {
+		top++
+	}
+	for bottom >= top && !rowLit(image[bottom]) {
+		bottom--
+	}
+	if top > bottom {
+		return "", fmt.Errorf("image is empty")
+	}
+	image = image[top : bottom+1]
+
+	var f *font
+	for _, candidate := range fonts {
+		if candidate.height == len(image) {
+			f = candidate
+		}
+	}
+	if f == nil {
+		return "", fmt.Errorf("text height is %d, expected 6 or 10", len(image))
+	}
+
+	var text strings.Builder
+	var unknown []int
+	for _, letter := range f.split(image) {
+		if r, ok := f.glyphs[glyphKey(image, letter[0], letter[1])]; ok {
+			text.WriteRune(r)
+		} else {
+			text.WriteRune('?')
+			unknown = append(unknown, letter[0])
+		}
+	}
+
+	if unknown != nil {
+		return "", &Error{Text: text.String(), Columns: unknown}
+	}
+
+	return text.String(), nil
+}
+
+// RecognizeRows reads the text drawn in an image given as rows of text, where
+// '#' marks a lit pixel
+func RecognizeRows(rows []string) (string, error) {
+	image := make([][]bool, len(rows))
+	for y, row := range rows {
+		image[y] = make([]bool, len(row))
+		for x, c := range row {
+			image[y][x] = c == '#'
+		}
+	}
+
+	return Recognize(image)
+}
+
+// split the image into the first and last columns of each letter; letters are
+// separated by empty columns, except when a wide letter touches the next one
+func (f *font) split(image [][]bool) [][2]int {
+	var letters [][2]int
+	width := 0
+	for _, row := range image {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	for x := 0; x < width; {
+		if !columnLit(image, x) {
+			x++
+			continue
+		}
+		end := x
+		for end+1 < width && columnLit(image, end+1) {
+			end++
+		}
+
+		for start := x; start <= end; start += f.cellWidth {
+			last := end
+			if end-x+1 > f.maxWidth && start+f.cellWidth-1 < end {
+				last = start + f.cellWidth - 1
+			}
+			letters = append(letters, [2]int{start, last})
+		}
+		x = end + 1
+	}
+
+	return letters
+}
+
+// the lit pixels between two columns, trimmed of their empty columns
+func glyphKey(image [][]bool, first, last int) string {
+	var key strings.Builder
+	for _, row := range image {
+		for x := first; x <= last; x++ {
+			if x < len(row) && row[x] {
+				key.WriteByte('#')
+			} else {
+				key.WriteByte('.')
+			}
+		}
+		key.WriteByte('\n')
+	}
+
+	return key.String()
+}
+
+func litColumns(image [][]bool) (first, last int) {
+	first, last = -1, -1
+	for x := 0; x < len(image[0]); x++ {
+		if columnLit(image, x) {
+			if first == -1 {
+				first = x
+			}
+			last = x
+		}
+	}
+
+	return first, last
+}
+
+func columnLit(image [][]bool, x int) bool {
+	for _, row := range image {
+		if x < len(row) && row[x] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func rowLit(row []bool) bool {
+	for _, lit := range row {
+		if lit {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,69 @@
+package ocr
+
+import (
+	"testing"
+)
+
+func TestRecognizeSmall(t *testing.T) {
+	rows := []string{
+		"..............................",
+		".#..#.####.#....#.....##......",
+		".#..#.#....#....#....#..#.....",
+		".####.###..#....#....#..#.....",
+		".#..#.#....#....#....#..#.....",
+		".#..#.#....#....#....#..#.....",
+		".#..#.####.####.####..##......",
+	}
+	if text, err := RecognizeRows(rows); err != nil || text != "HELLO" {
+		t.Errorf("Expected HELLO got %q, %v", text, err)
+	}
+}
+
+func TestRecognizeTouchingLetters(t *testing.T) {
+	rows := []string{
+		"#...#.##.",
+		"#...##..#",
+		".#.#.#..#",
+		"..#..####",
+		"..#..#..#",
+		"..#..#..#",
+	}
+	if text, err := RecognizeRows(rows); err != nil || text != "YA" {
+		t.Errorf("Expected YA got %q, %v", text, err)
+	}
+}
+
+func TestRecognizeLarge(t *testing.T) {
+	var rows []string
+	for y := range large['N'] {
+		rows = append(rows, large['N'][y]+".."+large['X'][y])
+	}
+	if text, err := RecognizeRows(rows); err != nil || text != "NX" {
+		t.Errorf("Expected NX got %q, %v", text, err)
+	}
+}
+
+func TestRecognizeUnknown(t *testing.T) {
+	rows := []string{
+		"####.#..#.####",
+		"#..#.#..#.#..#",
+		"#..#.####.#..#",
+		"#..#.#..#.#..#",
+		"#..#.#..#.#..#",
+		"####.#..#.####",
+	}
+	_, err := RecognizeRows(rows)
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected an unrecognized letters error got %v", err)
+	}
+	if e.Text != "?H?" || len(e.Columns) != 2 || e.Columns[0] != 0 || e.Columns[1] != 10 {
+		t.Errorf("Expected ?H? with columns [0 10] got %q with %v", e.Text, e.Columns)
+	}
+}
+
+func TestRecognizeEmpty(t *testing.T) {
+	if _, err := RecognizeRows([]string{"....", "...."}); err == nil {
+		t.Errorf("Expected an error for an empty image")
+	}
+}
@@ -0,0 +1,120 @@
+package orbit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// dotEscaper escapes the only characters special in the quoted strings of DOT
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// quoteDOT returns the name as a DOT quoted string, which unlike Go strings
+// holds any other character as it is
+func quoteDOT(name string) string {
+	return `"` + dotEscaper.Replace(name) + `"`
+}
+
+// WriteDOT writes the map as a Graphviz graph, every object pointing to the
+// objects orbiting it, with the objects of the path and the orbits between
+// them highlighted
+func (m *Map) WriteDOT(w io.Writer, path []string) error {
+	highlighted := make(map[string]bool)
+	edges := make(map[[2]string]bool)
+	for i, name := range path {
+		highlighted[name] = true
+		if i > 0 {
+			edges[[2]string{path[i-1], name}] = true
+			edges[[2]string{name, path[i-1]}] = true
+		}
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "digraph orbits {")
+	fmt.Fprintln(out, "\tnode [shape=circle, fontsize=10];")
+	for v, name := range m.names {
+		if highlighted[name] {
+			fmt.Fprintf(out, "\t%s [style=filled, fillcolor=\"#ffd040\"];\n", quoteDOT(name))
+		}
+		for _, c := range m.children[v] {
+			if edges[[2]string{name, m.names[c]}] {
+				fmt.Fprintf(out, "\t%s -> %s [color=\"#e04040\", penwidth=3];\n", quoteDOT(name), quoteDOT(m.names[c]))
+			} else {
+				fmt.Fprintf(out, "\t%s -> %s;\n", quoteDOT(name), quoteDOT(m.names[c]))
+			}
+		}
+	}
+	fmt.Fprintln(out, "}")
+
+	return out.Flush()
+}
+
+// Node is an object together with the objects orbiting it, the nested form of
+// the map written as JSON
+type Node struct {
+	Name   string `json:"name"`
+	Orbits []Node `json:"orbits,omitempty"`
+}
+
+// Tree returns the map as nested nodes starting at the root
+func (m *Map) Tree() Node {
+	return m.node(m.root)
+}
+
+func (m *Map) node(v int) Node {
+	n := Node{Name: m.names[v]}
+	for _, c := range m.children[v] {
+		n.Orbits = append(n.Orbits, m.node(c))
+	}
+
+	return n
+}
+
+// FromTree returns the map of the nested nodes, failing like New when an
+// object appears more than once
+func FromTree(root Node) (*Map, error) {
+	var orbits []parse.Orbit
+	var walk func(n Node) error
+	walk = func(n Node) error {
+		if n.Name == "" {
+			return &Error{Orbit: -1, Msg: fmt.Sprintf("an object orbiting %s has no name", orbits[len(orbits)-1].Center)}
+		}
+		for _, c := range n.Orbits {
+			orbits = append(orbits, parse.Orbit{Center: n.Name, Object: c.Name})
+			if err := walk(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if root.Name == "" {
+		return nil, &Error{Orbit: -1, Msg: "the root has no name"}
+	}
+	if err := walk(root); err != nil {
+		return nil, err
+	}
+
+	return New(orbits)
+}
+
+// WriteJSON writes the map as nested JSON nodes, see Node
+func (m *Map) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(m.Tree())
+}
+
+// ReadJSON reads a map written by WriteJSON, it is a parse.Parser
+func ReadJSON(name string, r io.Reader) (*Map, error) {
+	var root Node
+	if err := json.NewDecoder(r).Decode(&root); err != nil {
+		return nil, fmt.Errorf("%s: invalid orbit map: %w", name, err)
+	}
+
+	return FromTree(root)
+}
@@ -0,0 +1,312 @@
+// Package orbit holds maps of objects orbiting around each other: a tree
+// rooted at the one object orbiting nothing, the universal Center of Mass of
+// the puzzle, answering depth, common ancestor and path queries.
+package orbit
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+// Error tells why the orbits do not make a map, Orbit is the index of the
+// orbit at fault or -1 when no single orbit is
+type Error struct {
+	Orbit int
+	Msg   string
+}
+
+func (e *Error) Error() string {
+	if e.Orbit < 0 {
+		return fmt.Sprintf("invalid orbit map: %s", e.Msg)
+	}
+	return fmt.Sprintf("invalid orbit map at orbit %d: %s", e.Orbit, e.Msg)
+}
+
+// Map is the tree of the objects, every one of them but the root orbiting
+// around exactly one other
+type Map struct {
+	names []string
+	ids   map[string]int
+	// the index of the orbit of every object, -1 for the root
+	orbit    []int
+	parent   []int
+	children [][]int
+	depth    []int
+	// up[k][v] is the object 2^k levels above v, the root for the ones past it
+	up   [][]int
+	root int
+}
+
+// New returns the map of the orbits, failing with an *Error when an object
+// orbits more than one other, when objects orbit around each other in a
+// cycle or when the objects do not all orbit around the same root
+func New(orbits []parse.Orbit) (*Map, error) {
+	m := &Map{ids: make(map[string]int)}
+	id := func(name string) int {
+		if v, ok := m.ids[name]; ok {
+			return v
+		}
+		v := len(m.names)
+		m.ids[name] = v
+		m.names = append(m.names, name)
+		m.orbit = append(m.orbit, -1)
+		m.parent = append(m.parent, -1)
+		m.children = append(m.children, nil)
+		return v
+	}
+
+	for i, o := range orbits {
+		center, object := id(o.Center), id(o.Object)
+		if m.parent[object] >= 0 {
+			return nil, &Error{Orbit: i, Msg: fmt.Sprintf("%s orbits both %s and %s", o.Object, m.names[m.parent[object]], o.Center)}
+		}
+		m.parent[object] = center
+		m.orbit[object] = i
+		m.children[center] = append(m.children[center], object)
+	}
+	if len(m.names) == 0 {
+		return nil, &Error{Orbit: -1, Msg: "no orbits"}
+	}
+
+	var roots []int
+	for v, p := range m.parent {
+		if p < 0 {
+			roots = append(roots, v)
+		}
+	}
+
+	// the objects are walked from the roots level by level, the ones never
+	// reached orbit around a cycle
+	m.depth = make([]int, len(m.names))
+	reached := make([]bool, len(m.names))
+	queue := append([]int(nil), roots...)
+	for _, v := range roots {
+		reached[v] = true
+	}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, c := range m.children[v] {
+			reached[c] = true
+			m.depth[c] = m.depth[v] + 1
+			queue = append(queue, c)
+		}
+	}
+	for v := range m.names {
+		if !reached[v] {
+			return nil, m.cycle(v)
+		}
+	}
+
+	if len(roots) > 1 {
+		a, b := m.names[roots[0]], m.names[roots[1]]
+		return nil, &Error{Orbit: m.orbit[m.children[roots[1]][0]], Msg: fmt.Sprintf("the map has %d separate parts, %s and %s both orbit nothing", len(roots), a, b)}
+	}
+	m.root = roots[0]
+
+	m.up = [][]int{make([]int, len(m.names))}
+	for v, p := range m.parent {
+		if p < 0 {
+			p = v
+		}
+		m.up[0][v] = p
+	}
+	for k := 1; k < bits.Len(uint(len(m.names))); k++ {
+		prev := m.up[k-1]
+		level := make([]int, len(m.names))
+		for v := range level {
+			level[v] = prev[prev[v]]
+		}
+		m.up = append(m.up, level)
+	}
+
+	return m, nil
+}
+
+// cycle returns the error for the cycle the object orbits around
+func (m *Map) cycle(v int) error {
+	seen := make(map[int]bool)
+	for !seen[v] {
+		seen[v] = true
+		v = m.parent[v]
+	}
+
+	names := []string{m.names[v]}
+	for u := m.parent[v]; u != v; u = m.parent[u] {
+		names = append(names, m.names[u])
+	}
+	names = append(names, m.names[v])
+
+	return &Error{Orbit: m.orbit[v], Msg: "the objects orbit in a cycle: " + strings.Join(names, " orbits ")}
+}
+
+// Len returns the number of objects
+func (m *Map) Len() int {
+	return len(m.names)
+}
+
+// Root returns the object everything else orbits around
+func (m *Map) Root() string {
+	return m.names[m.root]
+}
+
+// Objects returns the names of the objects in the order they first appear in
+// the orbits
+func (m *Map) Objects() []string {
+	return append([]string(nil), m.names...)
+}
+
+// Has reports whether the object is on the map
+func (m *Map) Has(name string) bool {
+	_, ok := m.ids[name]
+	return ok
+}
+
+func (m *Map) lookup(names ...string) ([]int, error) {
+	ids := make([]int, len(names))
+	for i, name := range names {
+		v, ok := m.ids[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown object %q", name)
+		}
+		ids[i] = v
+	}
+
+	return ids, nil
+}
+
+// Parent returns the object the given one orbits directly, false for the
+// root and unknown objects
+func (m *Map) Parent(name string) (string, bool) {
+	v, ok := m.ids[name]
+	if !ok || m.parent[v] < 0 {
+		return "", false
+	}
+
+	return m.names[m.parent[v]], true
+}
+
+// Children returns the objects orbiting the given one directly
+func (m *Map) Children(name string) []string {
+	var children []string
+	if v, ok := m.ids[name]; ok {
+		for _, c := range m.children[v] {
+			children = append(children, m.names[c])
+		}
+	}
+
+	return children
+}
+
+// Depth returns the number of objects the given one orbits, directly or not
+func (m *Map) Depth(name string) (int, error) {
+	ids, err := m.lookup(name)
+	if err != nil {
+		return 0, err
+	}
+
+	return m.depth[ids[0]], nil
+}
+
+// Orbits returns the number of direct and indirect orbits of all the objects
+func (m *Map) Orbits() int {
+	total := 0
+	for _, d := range m.depth {
+		total += d
+	}
+
+	return total
+}
+
+// ancestor returns the object n levels above v
+func (m *Map) ancestor(v, n int) int {
+	for k := 0; n > 0; k, n = k+1, n>>1 {
+		if n&1 == 1 {
+			v = m.up[k][v]
+		}
+	}
+
+	return v
+}
+
+func (m *Map) lca(a, b int) int {
+	if m.depth[a] < m.depth[b] {
+		a, b = b, a
+	}
+	a = m.ancestor(a, m.depth[a]-m.depth[b])
+	if a == b {
+		return a
+	}
+	for k := len(m.up) - 1; k >= 0; k-- {
+		if m.up[k][a] != m.up[k][b] {
+			a, b = m.up[k][a], m.up[k][b]
+		}
+	}
+
+	return m.parent[a]
+}
+
+// Common returns the closest object both given ones orbit around, directly
+// or not, an object counting as orbiting around itself
+func (m *Map) Common(a, b string) (string, error) {
+	ids, err := m.lookup(a, b)
+	if err != nil {
+		return "", err
+	}
+
+	return m.names[m.lca(ids[0], ids[1])], nil
+}
+
+// Distance returns the number of orbits between the two objects
+func (m *Map) Distance(a, b string) (int, error) {
+	ids, err := m.lookup(a, b)
+	if err != nil {
+		return 0, err
+	}
+
+	return m.depth[ids[0]] + m.depth[ids[1]] - 2*m.depth[m.lca(ids[0], ids[1])], nil
+}
+
+// Path returns the objects from a to b, both included, going through the
+// closest object both of them orbit around
+func (m *Map) Path(a, b string) ([]string, error) {
+	ids, err := m.lookup(a, b)
+	if err != nil {
+		return nil, err
+	}
+	common := m.lca(ids[0], ids[1])
+
+	var path []string
+	for v := ids[0]; v != common; v = m.parent[v] {
+		path = append(path, m.names[v])
+	}
+	path = append(path, m.names[common])
+	var back []string
+	for v := ids[1]; v != common; v = m.parent[v] {
+		back = append(back, m.names[v])
+	}
+	for i := len(back) - 1; i >= 0; i-- {
+		path = append(path, back[i])
+	}
+
+	return path, nil
+}
+
+// Transfers returns the number of orbital transfers needed to move from the
+// object a orbits to the object b orbits
+func (m *Map) Transfers(a, b string) (int, error) {
+	ids, err := m.lookup(a, b)
+	if err != nil {
+		return 0, err
+	}
+	for i, v := range ids {
+		if m.parent[v] < 0 {
+			return 0, fmt.Errorf("%s orbits nothing", []string{a, b}[i])
+		}
+	}
+
+	return m.Distance(m.names[m.parent[ids[0]]], m.names[m.parent[ids[1]]])
+}
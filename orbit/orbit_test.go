@@ -0,0 +1,152 @@
+package orbit
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/parse"
+)
+
+func read(t *testing.T, text string) []parse.Orbit {
+	orbits, err := parse.Orbits("example", strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return orbits
+}
+
+const example = "COM)B\nB)C\nC)D\nD)E\nE)F\nB)G\nG)H\nD)I\nE)J\nJ)K\nK)L"
+
+func TestMap(t *testing.T) {
+	orbits := read(t, example+"\nK)YOU\nI)SAN")
+	m, err := New(orbits)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Root() != "COM" || m.Len() != 14 {
+		t.Errorf("Expected 14 objects around COM got %d around %s", m.Len(), m.Root())
+	}
+	if depth, _ := m.Depth("L"); depth != 7 {
+		t.Errorf("Expected L at depth 7 got %d", depth)
+	}
+	if orbits := m.Orbits(); orbits != 42+7+5 {
+		t.Errorf("Expected 54 orbits got %d", orbits)
+	}
+	if common, _ := m.Common("YOU", "SAN"); common != "D" {
+		t.Errorf("Expected D as the common object got %s", common)
+	}
+	if common, _ := m.Common("E", "L"); common != "E" {
+		t.Errorf("Expected E as the common object got %s", common)
+	}
+	if transfers, _ := m.Transfers("YOU", "SAN"); transfers != 4 {
+		t.Errorf("Expected 4 transfers got %d", transfers)
+	}
+	path, _ := m.Path("YOU", "SAN")
+	if expected := []string{"YOU", "K", "J", "E", "D", "I", "SAN"}; !reflect.DeepEqual(path, expected) {
+		t.Errorf("Expected path %v got %v", expected, path)
+	}
+	if _, err := m.Distance("YOU", "NOBODY"); err == nil {
+		t.Errorf("Expected an error for an unknown object")
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	tests := []struct {
+		orbits string
+		orbit  int
+		msg    string
+	}{
+		{"COM)B\nB)C\nA)C", 2, "C orbits both B and A"},
+		{"COM)B\nX)Y\nY)Z\nZ)X", 3, "the objects orbit in a cycle: X orbits Z orbits Y orbits X"},
+		{"COM)B\nX)X", 1, "the objects orbit in a cycle: X orbits X"},
+		{"COM)B\nB)C\nX)Y", 2, "the map has 2 separate parts, COM and X both orbit nothing"},
+	}
+
+	for _, test := range tests {
+		orbits := read(t, test.orbits)
+		_, err := New(orbits)
+		var e *Error
+		if !errors.As(err, &e) || e.Orbit != test.orbit || e.Msg != test.msg {
+			t.Errorf("%q: expected error %q at orbit %d got %v", test.orbits, test.msg, test.orbit, err)
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	m, err := New(read(t, example+"\nK)YOU\nI)SAN"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	if err := m.WriteJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	back, err := ReadJSON("example.json", strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back.Tree(), m.Tree()) || back.Orbits() != m.Orbits() {
+		t.Errorf("Expected the same map back from %s", b.String())
+	}
+
+	b.Reset()
+	path, _ := m.Path("YOU", "SAN")
+	if err := m.WriteDOT(&b, path); err != nil {
+		t.Fatal(err)
+	}
+	dot := b.String()
+	if n := strings.Count(dot, "->"); n != 13 {
+		t.Errorf("Expected 13 orbits got %d", n)
+	}
+	if n := strings.Count(dot, "penwidth"); n != 6 {
+		t.Errorf("Expected the 6 orbits of the path highlighted got %d", n)
+	}
+	if !strings.Contains(dot, `"D" -> "I" [color=`) {
+		t.Errorf("Expected D -> I highlighted in %s", dot)
+	}
+
+	b.Reset()
+	odd, err := New([]parse.Orbit{{Center: "COM", Object: "Ω"}, {Center: "Ω", Object: `a"b\c`}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := odd.WriteDOT(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), `"Ω" -> "a\"b\\c";`) {
+		t.Errorf("Expected the names quoted for DOT in %s", b.String())
+	}
+
+	tree := Node{Name: "COM", Orbits: []Node{{Name: "A"}, {Name: "B", Orbits: []Node{{Name: "A"}}}}}
+	if _, err := FromTree(tree); err == nil {
+		t.Errorf("Expected an error for A orbiting twice")
+	}
+}
+
+func BenchmarkCommon(b *testing.B) {
+	// a long chain with a branch at every object
+	var text strings.Builder
+	text.WriteString("COM)0")
+	for i := 1; i < 100_000; i++ {
+		text.WriteString("\n" + strconv.Itoa(i-1) + ")" + strconv.Itoa(i) + "\n" + strconv.Itoa(i-1) + ")b" + strconv.Itoa(i))
+	}
+	orbits, err := parse.Orbits("chain", strings.NewReader(text.String()))
+	if err != nil {
+		b.Fatal(err)
+	}
+	m, err := New(orbits)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Common("b99999", "b3")
+	}
+}
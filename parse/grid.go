@@ -0,0 +1,42 @@
+package parse
+
+import (
+	"io"
+	"strings"
+
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+// Grid returns the parser of a grid of symbols, every line being a row of the
+// same length; with symbols set only those symbols are allowed
+func Grid(symbols string) Parser[*grid.Dense[rune]] {
+	return func(name string, r io.Reader) (*grid.Dense[rune], error) {
+		lines, err := Lines(name, r)
+		if err != nil {
+			return nil, err
+		}
+		lines = trimEmpty(lines)
+		if len(lines) == 0 {
+			return nil, &Error{File: name, Line: 1, Msg: "empty grid"}
+		}
+
+		width := len([]rune(lines[0].Text))
+		rows := make([][]rune, len(lines))
+		for i, line := range lines {
+			rows[i] = []rune(line.Text)
+			if len(rows[i]) != width {
+				return nil, line.Errorf(0, "row of %d cells, expected %d like the first row", len(rows[i]), width)
+			}
+			if symbols == "" {
+				continue
+			}
+			for j, c := range rows[i] {
+				if !strings.ContainsRune(symbols, c) {
+					return nil, line.Errorf(j+1, "unexpected symbol %q, expected one of %q", c, symbols)
+				}
+			}
+		}
+
+		return grid.Parse(rows)
+	}
+}
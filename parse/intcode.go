@@ -0,0 +1,23 @@
+package parse
+
+import "io"
+
+// Intcode reads an Intcode program, a single line of integers separated by
+// commas
+func Intcode(name string, r io.Reader) ([]int64, error) {
+	line, err := Single(name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var program []int64
+	for _, f := range line.split(",") {
+		n, err := line.integer(f)
+		if err != nil {
+			return nil, err
+		}
+		program = append(program, n)
+	}
+
+	return program, nil
+}
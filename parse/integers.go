@@ -0,0 +1,22 @@
+package parse
+
+import "io"
+
+// Integers reads one integer on every line
+func Integers(name string, r io.Reader) ([]int64, error) {
+	lines, err := Lines(name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var integers []int64
+	for _, line := range trimEmpty(lines) {
+		n, err := line.integer(trim(field{text: line.Text, column: 1}))
+		if err != nil {
+			return nil, err
+		}
+		integers = append(integers, n)
+	}
+
+	return integers, nil
+}
@@ -0,0 +1,33 @@
+package parse
+
+import "io"
+
+// Orbit tells that Object orbits around Center
+type Orbit struct {
+	Center string
+	Object string
+}
+
+// Orbits reads a map of orbits, one CENTER)OBJECT pair on every line
+func Orbits(name string, r io.Reader) ([]Orbit, error) {
+	lines, err := Lines(name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var orbits []Orbit
+	for _, line := range trimEmpty(lines) {
+		fields := line.split(")")
+		if len(fields) != 2 {
+			return nil, line.Errorf(0, "expected CENTER)OBJECT, got %q", line.Text)
+		}
+		for _, f := range fields {
+			if f.text == "" {
+				return nil, line.Errorf(f.column, "missing object name")
+			}
+		}
+		orbits = append(orbits, Orbit{Center: fields[0].text, Object: fields[1].text})
+	}
+
+	return orbits, nil
+}
@@ -0,0 +1,156 @@
+// Package parse reads the puzzle inputs, reporting the file, line and column
+// of anything that does not match the expected format.
+package parse
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Error tells where a puzzle input does not match its format; columns count
+// runes from 1 and are 0 when the whole line is at fault
+type Error struct {
+	File   string
+	Line   int
+	Column int
+	Msg    string
+	// the error behind the message, if any, e.g. from strconv
+	Err error
+}
+
+func (e *Error) Error() string {
+	pos := fmt.Sprintf("%s:%d", e.File, e.Line)
+	if e.Column > 0 {
+		pos += fmt.Sprintf(":%d", e.Column)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", pos, e.Msg, e.Err)
+	}
+
+	return pos + ": " + e.Msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Parser reads one of the formats of the puzzle inputs, name is the file
+// reported by the errors
+type Parser[T any] func(name string, r io.Reader) (T, error)
+
+// File parses the file at path with the given parser
+func File[T any](path string, parse Parser[T]) (T, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	defer file.Close()
+
+	return parse(path, file)
+}
+
+// Line is a line of a puzzle input, numbered from 1
+type Line struct {
+	File   string
+	Number int
+	Text   string
+}
+
+// Errorf returns the error for the given column of the line
+func (l Line) Errorf(column int, format string, args ...interface{}) *Error {
+	return &Error{File: l.File, Line: l.Number, Column: column, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Lines reads the lines of r
+func Lines(name string, r io.Reader) ([]Line, error) {
+	scanner := bufio.NewScanner(r)
+	// the Intcode programs are long single lines
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	var lines []Line
+	for scanner.Scan() {
+		lines = append(lines, Line{File: name, Number: len(lines) + 1, Text: scanner.Text()})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// Single reads r, which must hold exactly one line besides empty lines at its
+// end
+func Single(name string, r io.Reader) (Line, error) {
+	lines, err := Lines(name, r)
+	if err != nil {
+		return Line{}, err
+	}
+	lines = trimEmpty(lines)
+	if len(lines) == 0 {
+		return Line{}, &Error{File: name, Line: 1, Msg: "empty input"}
+	}
+	if len(lines) > 1 {
+		return Line{}, lines[1].Errorf(0, "expected a single line, got %d", len(lines))
+	}
+
+	return lines[0], nil
+}
+
+// drop the empty lines at the end of the input
+func trimEmpty(lines []Line) []Line {
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1].Text) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// field is a piece of a line together with the column it starts at
+type field struct {
+	text   string
+	column int
+}
+
+// split the text of the line around sep, trimming the spaces around every
+// field
+func (l Line) split(sep string) []field {
+	return splitField(field{text: l.Text, column: 1}, sep)
+}
+
+func splitField(f field, sep string) []field {
+	var fields []field
+	column := f.column
+	for _, text := range strings.Split(f.text, sep) {
+		fields = append(fields, trim(field{text: text, column: column}))
+		column += len([]rune(text)) + len([]rune(sep))
+	}
+
+	return fields
+}
+
+func trim(f field) field {
+	trimmed := strings.TrimLeft(f.text, " \t")
+	f.column += len([]rune(f.text)) - len([]rune(trimmed))
+	f.text = strings.TrimRight(trimmed, " \t")
+
+	return f
+}
+
+// parse the field as an integer
+func (l Line) integer(f field) (int64, error) {
+	if f.text == "" {
+		return 0, l.Errorf(f.column, "missing integer")
+	}
+	n, err := strconv.ParseInt(f.text, 10, 64)
+	if err != nil {
+		e := l.Errorf(f.column, "invalid integer %q", f.text)
+		e.Err = err
+		return 0, e
+	}
+
+	return n, nil
+}
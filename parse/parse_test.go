@@ -0,0 +1,202 @@
+package parse
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+// check that err is an *Error at the given position
+func checkError(t *testing.T, err error, line, column int) {
+	t.Helper()
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected a parse error, got %v", err)
+	}
+	if e.File != "input" || e.Line != line || e.Column != column {
+		t.Errorf("expected an error at input:%d:%d, got %v", line, column, err)
+	}
+}
+
+func TestError(t *testing.T) {
+	err := &Error{File: "day1/input/part1.txt", Line: 3, Column: 7, Msg: "invalid integer \"x\"", Err: strconv.ErrSyntax}
+	if err.Error() != `day1/input/part1.txt:3:7: invalid integer "x": invalid syntax` {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected the error to wrap strconv.ErrSyntax")
+	}
+
+	err = &Error{File: "input", Line: 2, Msg: "expected a single line, got 2"}
+	if err.Error() != "input:2: expected a single line, got 2" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+}
+
+func TestIntcode(t *testing.T) {
+	program, err := Intcode("input", strings.NewReader("1,9, 10,-3\n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(program, []int64{1, 9, 10, -3}) {
+		t.Errorf("unexpected program %v", program)
+	}
+
+	_, err = Intcode("input", strings.NewReader("1,9,1O,3"))
+	checkError(t, err, 1, 5)
+	_, err = Intcode("input", strings.NewReader("1,,3"))
+	checkError(t, err, 1, 3)
+	_, err = Intcode("input", strings.NewReader("1,2\n3,4"))
+	checkError(t, err, 2, 0)
+}
+
+func TestGrid(t *testing.T) {
+	g, err := Grid(".#")("input", strings.NewReader(".#.\n##.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Width() != 3 || g.Height() != 2 || g.At(grid.Point{X: 1, Y: 1}) != '#' {
+		t.Errorf("unexpected grid %v", g.Render(func(c rune) rune { return c }))
+	}
+
+	_, err = Grid(".#")("input", strings.NewReader(".#.\n#.\n"))
+	checkError(t, err, 2, 0)
+	_, err = Grid(".#")("input", strings.NewReader(".#.\n#x.\n"))
+	checkError(t, err, 2, 2)
+	_, err = Grid("")("input", strings.NewReader(""))
+	checkError(t, err, 1, 0)
+}
+
+func TestOrbits(t *testing.T) {
+	orbits, err := Orbits("input", strings.NewReader("COM)B\nB)C\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(orbits, []Orbit{{"COM", "B"}, {"B", "C"}}) {
+		t.Errorf("unexpected orbits %v", orbits)
+	}
+
+	_, err = Orbits("input", strings.NewReader("COM)B\nB-C\n"))
+	checkError(t, err, 2, 0)
+	_, err = Orbits("input", strings.NewReader("COM)\n"))
+	checkError(t, err, 1, 5)
+}
+
+func TestWires(t *testing.T) {
+	wires, err := Wires("input", strings.NewReader("R8,U5\nL12\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]Move{{{grid.Right, 8}, {grid.Up, 5}}, {{grid.Left, 12}}}
+	if !reflect.DeepEqual(wires, expected) {
+		t.Errorf("unexpected wires %v", wires)
+	}
+
+	// the arrows are directions too
+	wires, err = Wires("input", strings.NewReader(">8,^5,v2,<1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = [][]Move{{{grid.Right, 8}, {grid.Up, 5}, {grid.Down, 2}, {grid.Left, 1}}}
+	if !reflect.DeepEqual(wires, expected) {
+		t.Errorf("unexpected wires %v", wires)
+	}
+
+	_, err = Wires("input", strings.NewReader("R8,X5"))
+	checkError(t, err, 1, 4)
+	if err == nil || !strings.Contains(err.Error(), "U, R, D, L or ^, >, v, <") {
+		t.Errorf("expected the error to list every direction, got %v", err)
+	}
+	_, err = Wires("input", strings.NewReader("R8\nU5,Lx"))
+	checkError(t, err, 2, 5)
+	_, err = Wires("input", strings.NewReader("R0"))
+	checkError(t, err, 1, 2)
+}
+
+func TestTechniques(t *testing.T) {
+	techniques, err := Techniques("input", strings.NewReader("deal into new stack\ncut -2\ndeal with increment 7\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Technique{{NewStack, 0}, {Cut, -2}, {Increment, 7}}
+	if !reflect.DeepEqual(techniques, expected) {
+		t.Errorf("unexpected techniques %v", techniques)
+	}
+
+	_, err = Techniques("input", strings.NewReader("cut 3\nshuffle 5"))
+	checkError(t, err, 2, 1)
+	_, err = Techniques("input", strings.NewReader("deal with increment x"))
+	checkError(t, err, 1, 21)
+}
+
+func TestVectors(t *testing.T) {
+	vectors, err := Vectors("input", strings.NewReader("<x=-1, y=0, z=2>\n<x=2, y=-10, z=-7>\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(vectors, [][3]int64{{-1, 0, 2}, {2, -10, -7}}) {
+		t.Errorf("unexpected vectors %v", vectors)
+	}
+
+	_, err = Vectors("input", strings.NewReader("<x=-1, y=0, w=2>"))
+	checkError(t, err, 1, 13)
+	_, err = Vectors("input", strings.NewReader("<x=-1, y=0, z=2"))
+	checkError(t, err, 1, 16)
+	_, err = Vectors("input", strings.NewReader("<x=-1, y=a, z=2>"))
+	checkError(t, err, 1, 10)
+}
+
+func TestReactions(t *testing.T) {
+	reactions, err := Reactions("input", strings.NewReader("10 ORE => 10 A\n7 A, 1 E => 1 FUEL\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Reaction{
+		{Inputs: []Quantity{{10, "ORE"}}, Output: Quantity{10, "A"}},
+		{Inputs: []Quantity{{7, "A"}, {1, "E"}}, Output: Quantity{1, "FUEL"}},
+	}
+	if !reflect.DeepEqual(reactions, expected) {
+		t.Errorf("unexpected reactions %v", reactions)
+	}
+
+	_, err = Reactions("input", strings.NewReader("10 ORE => 10 A\n7 A, 1 E -> 1 FUEL\n"))
+	checkError(t, err, 2, 0)
+	_, err = Reactions("input", strings.NewReader("7 A, x E => 1 FUEL"))
+	checkError(t, err, 1, 6)
+	_, err = Reactions("input", strings.NewReader("7 A, 1 e => 1 FUEL"))
+	checkError(t, err, 1, 8)
+}
+
+func TestRange(t *testing.T) {
+	interval, err := Range("input", strings.NewReader("123-456\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interval != (Interval{123, 456}) {
+		t.Errorf("unexpected interval %v", interval)
+	}
+
+	_, err = Range("input", strings.NewReader("123-4x6"))
+	checkError(t, err, 1, 5)
+	_, err = Range("input", strings.NewReader("456-123"))
+	checkError(t, err, 1, 5)
+	_, err = Range("input", strings.NewReader("123"))
+	checkError(t, err, 1, 0)
+}
+
+func TestIntegers(t *testing.T) {
+	integers, err := Integers("input", strings.NewReader("12\n -3\n1969\n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(integers, []int64{12, -3, 1969}) {
+		t.Errorf("unexpected integers %v", integers)
+	}
+
+	_, err = Integers("input", strings.NewReader("12\n\n14"))
+	checkError(t, err, 2, 1)
+}
@@ -0,0 +1,34 @@
+package parse
+
+import "io"
+
+// Interval holds the integers from Min to Max, both included
+type Interval struct {
+	Min int64
+	Max int64
+}
+
+// Range reads a single interval of integers written as MIN-MAX
+func Range(name string, r io.Reader) (Interval, error) {
+	line, err := Single(name, r)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	fields := line.split("-")
+	if len(fields) != 2 {
+		return Interval{}, line.Errorf(0, "expected MIN-MAX, got %q", line.Text)
+	}
+	var interval Interval
+	if interval.Min, err = line.integer(fields[0]); err != nil {
+		return Interval{}, err
+	}
+	if interval.Max, err = line.integer(fields[1]); err != nil {
+		return Interval{}, err
+	}
+	if interval.Min > interval.Max {
+		return Interval{}, line.Errorf(fields[1].column, "range end %d is before its start %d", interval.Max, interval.Min)
+	}
+
+	return interval, nil
+}
@@ -0,0 +1,73 @@
+package parse
+
+import (
+	"io"
+	"strings"
+)
+
+// Quantity is an amount of a chemical
+type Quantity struct {
+	Amount   int64
+	Chemical string
+}
+
+// Reaction produces its output out of its inputs
+type Reaction struct {
+	Inputs []Quantity
+	Output Quantity
+}
+
+// Reactions reads the reactions, one on every line, written as
+// 7 A, 1 E => 1 FUEL
+func Reactions(name string, r io.Reader) ([]Reaction, error) {
+	lines, err := Lines(name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var reactions []Reaction
+	for _, line := range trimEmpty(lines) {
+		sides := line.split("=>")
+		if len(sides) != 2 {
+			return nil, line.Errorf(0, "expected INPUTS => OUTPUT, got %q", line.Text)
+		}
+
+		var reaction Reaction
+		for _, f := range splitField(sides[0], ",") {
+			q, err := line.quantity(f)
+			if err != nil {
+				return nil, err
+			}
+			reaction.Inputs = append(reaction.Inputs, q)
+		}
+		if reaction.Output, err = line.quantity(sides[1]); err != nil {
+			return nil, err
+		}
+		reactions = append(reactions, reaction)
+	}
+
+	return reactions, nil
+}
+
+// parse a quantity written as 7 A
+func (l Line) quantity(f field) (Quantity, error) {
+	space := strings.IndexAny(f.text, " \t")
+	if space < 0 {
+		return Quantity{}, l.Errorf(f.column, "expected AMOUNT CHEMICAL, got %q", f.text)
+	}
+	amount, err := l.integer(field{text: f.text[:space], column: f.column})
+	if err != nil {
+		return Quantity{}, err
+	}
+	if amount < 1 {
+		return Quantity{}, l.Errorf(f.column, "amount must be positive, got %d", amount)
+	}
+	chemical := trim(field{text: f.text[space:], column: f.column + space})
+	for i, c := range chemical.text {
+		if c < 'A' || c > 'Z' {
+			return Quantity{}, l.Errorf(chemical.column+len([]rune(chemical.text[:i])), "invalid chemical name %q", chemical.text)
+		}
+	}
+
+	return Quantity{Amount: amount, Chemical: chemical.text}, nil
+}
@@ -0,0 +1,56 @@
+package parse
+
+import (
+	"io"
+	"strings"
+)
+
+// Shuffle is a technique used to shuffle a deck of space cards
+type Shuffle int
+
+const (
+	NewStack Shuffle = iota
+	Cut
+	Increment
+)
+
+// Technique is a shuffle technique with its argument, which is 0 for
+// NewStack
+type Technique struct {
+	Shuffle Shuffle
+	N       int
+}
+
+// Techniques reads the shuffle process, one technique on every line
+func Techniques(name string, r io.Reader) ([]Technique, error) {
+	lines, err := Lines(name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var techniques []Technique
+	for _, line := range trimEmpty(lines) {
+		f := trim(field{text: line.Text, column: 1})
+		var shuffle Shuffle
+		var prefix string
+		switch {
+		case f.text == "deal into new stack":
+			techniques = append(techniques, Technique{Shuffle: NewStack})
+			continue
+		case strings.HasPrefix(f.text, "cut "):
+			shuffle, prefix = Cut, "cut "
+		case strings.HasPrefix(f.text, "deal with increment "):
+			shuffle, prefix = Increment, "deal with increment "
+		default:
+			return nil, line.Errorf(f.column, "unsupported technique %q", f.text)
+		}
+
+		n, err := line.integer(trim(field{text: f.text[len(prefix):], column: f.column + len(prefix)}))
+		if err != nil {
+			return nil, err
+		}
+		techniques = append(techniques, Technique{Shuffle: shuffle, N: int(n)})
+	}
+
+	return techniques, nil
+}
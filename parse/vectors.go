@@ -0,0 +1,44 @@
+package parse
+
+import (
+	"io"
+	"strings"
+)
+
+// Vectors reads 3D vectors, one on every line, written as <x=-1, y=0, z=2>
+func Vectors(name string, r io.Reader) ([][3]int64, error) {
+	lines, err := Lines(name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var vectors [][3]int64
+	for _, line := range trimEmpty(lines) {
+		f := trim(field{text: line.Text, column: 1})
+		if !strings.HasPrefix(f.text, "<") {
+			return nil, line.Errorf(f.column, "expected '<'")
+		}
+		if !strings.HasSuffix(f.text, ">") {
+			return nil, line.Errorf(f.column+len([]rune(f.text)), "expected '>'")
+		}
+		inner := field{text: f.text[1 : len(f.text)-1], column: f.column + 1}
+
+		coordinates := splitField(inner, ",")
+		if len(coordinates) != 3 {
+			return nil, line.Errorf(0, "expected 3 coordinates, got %d", len(coordinates))
+		}
+		var v [3]int64
+		for i, c := range coordinates {
+			axis := string(rune('x' + i))
+			if !strings.HasPrefix(c.text, axis+"=") {
+				return nil, line.Errorf(c.column, "expected %s=", axis)
+			}
+			if v[i], err = line.integer(field{text: c.text[2:], column: c.column + 2}); err != nil {
+				return nil, err
+			}
+		}
+		vectors = append(vectors, v)
+	}
+
+	return vectors, nil
+}
@@ -0,0 +1,47 @@
+package parse
+
+import (
+	"io"
+
+	"github.com/stanciua/adventofcode2019/grid"
+)
+
+// Move goes a number of steps in one direction
+type Move struct {
+	Direction grid.Direction
+	Steps     int
+}
+
+// Wires reads the paths of the wires, one on every line, as moves separated
+// by commas, e.g. R8,U5,L5
+func Wires(name string, r io.Reader) ([][]Move, error) {
+	lines, err := Lines(name, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var wires [][]Move
+	for _, line := range trimEmpty(lines) {
+		var path []Move
+		for _, f := range line.split(",") {
+			if f.text == "" {
+				return nil, line.Errorf(f.column, "missing move")
+			}
+			direction, ok := grid.ParseDirection([]rune(f.text)[0])
+			if !ok {
+				return nil, line.Errorf(f.column, "invalid direction %q, expected one of U, R, D, L or ^, >, v, <", []rune(f.text)[0])
+			}
+			steps, err := line.integer(field{text: f.text[1:], column: f.column + 1})
+			if err != nil {
+				return nil, err
+			}
+			if steps < 1 {
+				return nil, line.Errorf(f.column+1, "steps must be positive, got %d", steps)
+			}
+			path = append(path, Move{Direction: direction, Steps: int(steps)})
+		}
+		wires = append(wires, path)
+	}
+
+	return wires, nil
+}
@@ -0,0 +1,148 @@
+package sif
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Decoder reads the layers of an image one at a time from a stream of digits,
+// using the same memory whatever the number of layers
+type Decoder struct {
+	r      *bufio.Reader
+	offset int
+	digits []byte
+	layer  Layer
+	// the error of an invalid layer size, returned by every call to Next
+	err error
+}
+
+// the most pixels of a layer, far more than any picture needs
+const maxLayerSize = 1 << 26
+
+// checkSize returns an error for the layer sizes below 1 and the ones with
+// too many pixels to hold
+func checkSize(width, height int) error {
+	if width < 1 || height < 1 || width > math.MaxInt/height || width*height > maxLayerSize {
+		return fmt.Errorf("invalid layer size %dx%d", width, height)
+	}
+
+	return nil
+}
+
+// NewDecoder returns a decoder of the layers of the given size read from r,
+// an invalid size making Next fail
+func NewDecoder(r io.Reader, width, height int) *Decoder {
+	if err := checkSize(width, height); err != nil {
+		return &Decoder{err: err}
+	}
+	return &Decoder{
+		r:      bufio.NewReaderSize(r, 64*1024),
+		digits: make([]byte, width*height),
+		layer:  NewLayer(width, height, Transparent),
+	}
+}
+
+// Next returns the next layer, which is overwritten by the following call,
+// and io.EOF once every layer has been read; white space at the end of the
+// stream is ignored
+func (d *Decoder) Next() (Layer, error) {
+	if d.err != nil {
+		return Layer{}, d.err
+	}
+
+	n, err := io.ReadFull(d.r, d.digits)
+	if n == 0 && err == io.EOF {
+		return Layer{}, io.EOF
+	}
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return Layer{}, err
+	}
+	if isSpace(d.digits[0]) {
+		return Layer{}, d.end(d.digits[:n])
+	}
+	if err == io.ErrUnexpectedEOF {
+		// a layer cut short, possibly followed by white space
+		end := n
+		for end > 0 && isSpace(d.digits[end-1]) {
+			end--
+		}
+		if err := decodeLayer(d.layer.Pixels[:end], d.digits[:end], d.offset); err != nil {
+			return Layer{}, err
+		}
+		return Layer{}, &Error{Offset: d.offset, Msg: fmt.Sprintf("the last layer has %d digits instead of %d", end, len(d.digits))}
+	}
+
+	if err := decodeLayer(d.layer.Pixels, d.digits, d.offset); err != nil {
+		return Layer{}, err
+	}
+	d.offset += n
+
+	return d.layer, nil
+}
+
+// check that only white space is left in the stream, starting with the given
+// bytes
+func (d *Decoder) end(read []byte) error {
+	for i, b := range read {
+		if !isSpace(b) {
+			return &Error{Offset: d.offset + i, Msg: "digits after the end of the image"}
+		}
+	}
+	offset := d.offset + len(read)
+	for {
+		b, err := d.r.ReadByte()
+		if errors.Is(err, io.EOF) {
+			return io.EOF
+		}
+		if err != nil {
+			return err
+		}
+		if !isSpace(b) {
+			return &Error{Offset: offset, Msg: "digits after the end of the image"}
+		}
+		offset++
+	}
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
+
+// Summary is what a single pass over the layers tells about an image
+type Summary struct {
+	Layers    int
+	Composite Layer
+	Checksum  int
+}
+
+// Summarize reads every layer of the stream once, compositing them on the fly
+// and computing the checksum of the image
+func Summarize(r io.Reader, width, height int) (Summary, error) {
+	if err := checkSize(width, height); err != nil {
+		return Summary{}, err
+	}
+	d := NewDecoder(r, width, height)
+	s := Summary{Composite: NewLayer(width, height, Transparent)}
+	var fewest fewestZeros
+	for {
+		l, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Summary{}, err
+		}
+		s.Layers++
+		compose(s.Composite, l)
+		fewest.add(l)
+	}
+	if s.Layers == 0 {
+		return Summary{}, &Error{Offset: 0, Msg: "no layers"}
+	}
+	s.Checksum = fewest.checksum()
+
+	return s, nil
+}
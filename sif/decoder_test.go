@@ -0,0 +1,129 @@
+package sif
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecoder(t *testing.T) {
+	d := NewDecoder(strings.NewReader("123456789012\n"), 3, 2)
+	var layers [][]byte
+	for {
+		l, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		layers = append(layers, append([]byte(nil), l.Pixels...))
+	}
+
+	expected := [][]byte{{1, 2, 3, 4, 5, 6}, {7, 8, 9, 0, 1, 2}}
+	if !reflect.DeepEqual(layers, expected) {
+		t.Errorf("expected %v, got %v", expected, layers)
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	tests := []struct {
+		data   string
+		offset int
+	}{
+		{"1234567890\n", 6},
+		{"123456789x12", 9},
+		{"123456\n7", 7},
+	}
+	for _, test := range tests {
+		_, err := Summarize(strings.NewReader(test.data), 3, 2)
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Errorf("%q: expected a SIF error, got %v", test.data, err)
+		} else if e.Offset != test.offset {
+			t.Errorf("%q: expected an error at offset %d, got %v", test.data, test.offset, err)
+		}
+	}
+
+	for _, size := range [][2]int{{0, 2}, {3, 0}, {-3, 2}, {3, -2}, {1 << 32, 1 << 32}, {1 << 20, 1 << 20}} {
+		if _, err := Summarize(strings.NewReader("123456"), size[0], size[1]); err == nil {
+			t.Errorf("%dx%d: expected an invalid layer size", size[0], size[1])
+		}
+		if _, err := NewDecoder(strings.NewReader("123456"), size[0], size[1]).Next(); err == nil || err == io.EOF {
+			t.Errorf("%dx%d: expected the decoder to fail, got %v", size[0], size[1], err)
+		}
+	}
+}
+
+// layers reads count copies of the same layer without holding them in memory
+type layers struct {
+	layer string
+	count int
+	pos   int
+}
+
+func (l *layers) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) && l.count > 0 {
+		c := copy(p[n:], l.layer[l.pos:])
+		n += c
+		l.pos += c
+		if l.pos == len(l.layer) {
+			l.pos = 0
+			l.count--
+		}
+	}
+	if n == 0 {
+		return 0, io.EOF
+	}
+
+	return n, nil
+}
+
+func TestSummarize(t *testing.T) {
+	// a front layer with a window on the layers behind it
+	front := strings.Repeat("0", 25*6)
+	front = front[:10] + "22" + front[12:]
+	r := io.MultiReader(strings.NewReader(front), &layers{layer: strings.Repeat("1", 25*6), count: 100000})
+
+	s, err := Summarize(r, 25, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Layers != 100001 {
+		t.Errorf("expected 100001 layers, got %d", s.Layers)
+	}
+	// the layers of ones have no 0 digits, but no 2 digits either
+	if s.Checksum != 0 {
+		t.Errorf("expected checksum 0, got %d", s.Checksum)
+	}
+	if s.Composite.Pixels[9] != Black || s.Composite.Pixels[10] != White || s.Composite.Pixels[11] != White {
+		t.Errorf("unexpected composite %v", s.Composite.Pixels[:12])
+	}
+}
+
+func TestSummarizeMatchesDecode(t *testing.T) {
+	data := "0222112222120000"
+	img, err := Decode([]byte(data), 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := Summarize(strings.NewReader(data), 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s.Composite, img.Composite()) || s.Checksum != img.Checksum() || s.Layers != len(img.Layers) {
+		t.Errorf("expected %v, %d, %d, got %+v", img.Composite(), img.Checksum(), len(img.Layers), s)
+	}
+}
+
+func BenchmarkSummarize(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		r := &layers{layer: strings.Repeat("012", 50), count: 10000}
+		if _, err := Summarize(r, 25, 6); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package sif
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+// the colors of the black, white and transparent pixels, other digits have no
+// color and are drawn transparent
+var palette = color.Palette{color.Black, color.White, color.Transparent}
+
+// Image returns the picture of the layer, one image pixel for every pixel
+func (l Layer) Image() *image.Paletted {
+	img := image.NewPaletted(image.Rect(0, 0, l.Width, l.Height), palette)
+	for i, p := range l.Pixels {
+		if p > Transparent {
+			p = Transparent
+		}
+		img.Pix[(i/l.Width)*img.Stride+i%l.Width] = p
+	}
+
+	return img
+}
+
+// FromImage returns the layer of the picture: mostly transparent pixels are
+// transparent, the others black or white depending on their brightness
+func FromImage(m image.Image) Layer {
+	b := m.Bounds()
+	l := NewLayer(b.Dx(), b.Dy(), Transparent)
+	for y := 0; y < l.Height; y++ {
+		for x := 0; x < l.Width; x++ {
+			c := color.NRGBA64Model.Convert(m.At(b.Min.X+x, b.Min.Y+y)).(color.NRGBA64)
+			if c.A < 0x8000 {
+				continue
+			}
+			if color.Gray16Model.Convert(color.NRGBA64{R: c.R, G: c.G, B: c.B, A: 0xffff}).(color.Gray16).Y >= 0x8000 {
+				l.Set(x, y, White)
+			} else {
+				l.Set(x, y, Black)
+			}
+		}
+	}
+
+	return l
+}
+
+// WritePNG encodes the layer as a PNG image
+func WritePNG(w io.Writer, l Layer) error {
+	return png.Encode(w, l.Image())
+}
+
+// ReadPNG decodes a PNG image into a layer
+func ReadPNG(r io.Reader) (Layer, error) {
+	m, err := png.Decode(r)
+	if err != nil {
+		return Layer{}, err
+	}
+
+	return FromImage(m), nil
+}
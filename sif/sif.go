@@ -0,0 +1,190 @@
+// Package sif encodes and decodes images in the Space Image Format: layers of
+// digits of the same size stacked on top of each other, the first layer being
+// in front.
+package sif
+
+import (
+	"fmt"
+)
+
+// the colors of the pixels
+const (
+	Black       byte = 0
+	White       byte = 1
+	Transparent byte = 2
+)
+
+// Error tells where the data of an image is not valid, Offset counts the
+// digits from 0
+type Error struct {
+	Offset int
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid SIF data at offset %d: %s", e.Offset, e.Msg)
+}
+
+// Layer is a rectangle of pixels with a digit each, stored row by row
+type Layer struct {
+	Width  int
+	Height int
+	Pixels []byte
+}
+
+// NewLayer returns a layer of the given size with every pixel set to fill
+func NewLayer(width, height int, fill byte) Layer {
+	l := Layer{Width: width, Height: height, Pixels: make([]byte, width*height)}
+	for i := range l.Pixels {
+		l.Pixels[i] = fill
+	}
+
+	return l
+}
+
+// At returns the pixel at column x of row y
+func (l Layer) At(x, y int) byte {
+	return l.Pixels[y*l.Width+x]
+}
+
+// Set changes the pixel at column x of row y
+func (l Layer) Set(x, y int, v byte) {
+	l.Pixels[y*l.Width+x] = v
+}
+
+// Counts returns how many pixels of every digit the layer has
+func (l Layer) Counts() [10]int {
+	var counts [10]int
+	for _, p := range l.Pixels {
+		counts[p]++
+	}
+
+	return counts
+}
+
+// Image is a stack of layers of the same size
+type Image struct {
+	Width  int
+	Height int
+	Layers []Layer
+}
+
+// NewImage stacks the layers, which must all be of the same size, into an
+// image
+func NewImage(layers ...Layer) (*Image, error) {
+	if len(layers) == 0 {
+		return nil, fmt.Errorf("an image needs at least one layer")
+	}
+	img := &Image{Width: layers[0].Width, Height: layers[0].Height, Layers: layers}
+	for i, l := range layers {
+		if l.Width != img.Width || l.Height != img.Height || len(l.Pixels) != l.Width*l.Height {
+			return nil, fmt.Errorf("layer %d is %dx%d with %d pixels, expected %dx%d", i, l.Width, l.Height, len(l.Pixels), img.Width, img.Height)
+		}
+		for j, p := range l.Pixels {
+			if p > 9 {
+				return nil, fmt.Errorf("pixel %d of layer %d is %d, not a digit", j, i, p)
+			}
+		}
+	}
+
+	return img, nil
+}
+
+// Decode reads the digits of an image with layers of the given size, the data
+// must hold a whole number of layers
+func Decode(data []byte, width, height int) (*Image, error) {
+	if err := checkSize(width, height); err != nil {
+		return nil, err
+	}
+	size := width * height
+	if len(data) == 0 {
+		return nil, &Error{Offset: 0, Msg: "no layers"}
+	}
+	if len(data)%size != 0 {
+		return nil, &Error{Offset: len(data) - len(data)%size, Msg: fmt.Sprintf("%d digits are not a whole number of %dx%d layers", len(data), width, height)}
+	}
+
+	img := &Image{Width: width, Height: height}
+	for start := 0; start < len(data); start += size {
+		l := Layer{Width: width, Height: height, Pixels: make([]byte, size)}
+		if err := decodeLayer(l.Pixels, data[start:start+size], start); err != nil {
+			return nil, err
+		}
+		img.Layers = append(img.Layers, l)
+	}
+
+	return img, nil
+}
+
+// turn the digits at the given offset into pixels
+func decodeLayer(pixels, digits []byte, offset int) error {
+	for i, d := range digits {
+		if d < '0' || d > '9' {
+			return &Error{Offset: offset + i, Msg: fmt.Sprintf("%q is not a digit", d)}
+		}
+		pixels[i] = d - '0'
+	}
+
+	return nil
+}
+
+// Encode returns the digits of the image, layer after layer
+func Encode(img *Image) []byte {
+	data := make([]byte, 0, len(img.Layers)*img.Width*img.Height)
+	for _, l := range img.Layers {
+		for _, p := range l.Pixels {
+			data = append(data, '0'+p)
+		}
+	}
+
+	return data
+}
+
+// Composite returns the image as seen from the front, every pixel taking the
+// color of the first layer where it is not transparent; pixels transparent
+// on every layer stay transparent
+func (img *Image) Composite() Layer {
+	result := NewLayer(img.Width, img.Height, Transparent)
+	for _, l := range img.Layers {
+		compose(result, l)
+	}
+
+	return result
+}
+
+// draw the layer behind the pixels of result that are still transparent
+func compose(result, l Layer) {
+	for i, p := range result.Pixels {
+		if p == Transparent {
+			result.Pixels[i] = l.Pixels[i]
+		}
+	}
+}
+
+// Checksum returns the number of 1 digits multiplied by the number of 2
+// digits of the layer with the fewest 0 digits, the first one on ties
+func (img *Image) Checksum() int {
+	var fewest fewestZeros
+	for _, l := range img.Layers {
+		fewest.add(l)
+	}
+
+	return fewest.checksum()
+}
+
+// fewestZeros keeps the digit counts of the first layer with the fewest 0
+// digits among the layers added
+type fewestZeros struct {
+	counts [10]int
+	seen   bool
+}
+
+func (f *fewestZeros) add(l Layer) {
+	if counts := l.Counts(); !f.seen || counts[0] < f.counts[0] {
+		f.counts, f.seen = counts, true
+	}
+}
+
+func (f *fewestZeros) checksum() int {
+	return f.counts[1] * f.counts[2]
+}
@@ -0,0 +1,101 @@
+package sif
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	img, err := Decode([]byte("123456789012"), 3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(img.Layers) != 2 {
+		t.Fatalf("expected 2 layers, got %d", len(img.Layers))
+	}
+	if img.Layers[0].At(2, 1) != 6 || img.Layers[1].At(0, 0) != 7 {
+		t.Errorf("unexpected layers %v", img.Layers)
+	}
+	if string(Encode(img)) != "123456789012" {
+		t.Errorf("expected the image to encode back, got %s", Encode(img))
+	}
+	if checksum := img.Checksum(); checksum != 1 {
+		t.Errorf("expected checksum 1, got %d", checksum)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		data   string
+		offset int
+	}{
+		{"1234567890", 6},
+		{"1234x6", 4},
+		{"", 0},
+	}
+	for _, test := range tests {
+		_, err := Decode([]byte(test.data), 3, 2)
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Errorf("%q: expected a SIF error, got %v", test.data, err)
+		} else if e.Offset != test.offset {
+			t.Errorf("%q: expected an error at offset %d, got %v", test.data, test.offset, err)
+		}
+	}
+
+	if _, err := Decode([]byte("12"), 0, 2); err == nil {
+		t.Errorf("expected an error for an empty layer size")
+	}
+	// a size overflowing to 0 pixels
+	if _, err := Decode([]byte("12"), 1<<32, 1<<32); err == nil {
+		t.Errorf("expected an error for a layer size too large")
+	}
+}
+
+func TestComposite(t *testing.T) {
+	img, err := Decode([]byte("0222112222120000"), 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pixels := img.Composite().Pixels; !reflect.DeepEqual(pixels, []byte{0, 1, 1, 0}) {
+		t.Errorf("expected 0110, got %v", pixels)
+	}
+
+	img, err = Decode([]byte("2212"), 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pixels := img.Composite().Pixels; !reflect.DeepEqual(pixels, []byte{1, 2}) {
+		t.Errorf("expected the pixel transparent on every layer to stay transparent, got %v", pixels)
+	}
+}
+
+func TestNewImage(t *testing.T) {
+	if _, err := NewImage(NewLayer(2, 2, Black), NewLayer(3, 2, Black)); err == nil {
+		t.Errorf("expected an error for layers of different sizes")
+	}
+	img, err := NewImage(NewLayer(2, 1, White), NewLayer(2, 1, Black))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(Encode(img)) != "1100" {
+		t.Errorf("expected 1100, got %s", Encode(img))
+	}
+}
+
+func TestPNG(t *testing.T) {
+	l := Layer{Width: 3, Height: 2, Pixels: []byte{0, 1, 2, 2, 1, 0}}
+	var buf bytes.Buffer
+	if err := WritePNG(&buf, l); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ReadPNG(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, l) {
+		t.Errorf("expected %v, got %v", l, decoded)
+	}
+}
@@ -0,0 +1,114 @@
+// Package terminal redraws the grids of the puzzles in place on an ANSI
+// terminal, to watch a simulation while it runs.
+package terminal
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"io"
+	"time"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+// ANSI escape sequences used to draw the screen
+const (
+	home        = "\x1b[H"
+	clearScreen = "\x1b[2J"
+	clearLine   = "\x1b[K"
+	clearBelow  = "\x1b[J"
+	reset       = "\x1b[0m"
+	hideCursor  = "\x1b[?25l"
+	showCursor  = "\x1b[?25h"
+)
+
+// Screen draws rows of symbols at the top of the terminal, every symbol as a
+// square of its palette color, followed by a status line; frames are drawn
+// no faster than the frame rate
+type Screen struct {
+	w          io.Writer
+	colors     map[rune]string
+	background string
+	interval   time.Duration
+	next       time.Time
+	started    bool
+}
+
+// NewScreen returns a screen writing to w with the colors of the palette, a
+// frame rate that is not positive draws the frames as fast as they come
+func NewScreen(w io.Writer, p imaging.Palette, fps int) *Screen {
+	s := &Screen{w: w, colors: make(map[rune]string, len(p.Colors))}
+	background := p.Background
+	if background == nil {
+		background = color.Black
+	}
+	s.background = sgr(background)
+	for symbol, c := range p.Colors {
+		s.colors[symbol] = sgr(c)
+	}
+	if fps > 0 {
+		s.interval = time.Second / time.Duration(fps)
+	}
+
+	return s
+}
+
+// the sequence setting the background to the 24-bit color
+func sgr(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm", r>>8, g>>8, b>>8)
+}
+
+// Draw replaces the previous frame with the rows and the status line, waiting
+// for the frame rate first
+func (s *Screen) Draw(rows []string, status string) error {
+	var buf bytes.Buffer
+	if !s.started {
+		buf.WriteString(hideCursor + clearScreen)
+		s.started = true
+	}
+	buf.WriteString(home)
+
+	for _, row := range rows {
+		current := ""
+		for _, symbol := range row {
+			code, ok := s.colors[symbol]
+			if !ok {
+				code = s.background
+			}
+			if code != current {
+				buf.WriteString(code)
+				current = code
+			}
+			// terminal cells are about twice as tall as they are wide
+			buf.WriteString("  ")
+		}
+		buf.WriteString(reset + clearLine + "\n")
+	}
+	buf.WriteString(status + clearLine + "\n" + clearBelow)
+
+	s.wait()
+	_, err := s.w.Write(buf.Bytes())
+	return err
+}
+
+// sleep until the next frame is due
+func (s *Screen) wait() {
+	if s.interval == 0 {
+		return
+	}
+	if d := time.Until(s.next); d > 0 {
+		time.Sleep(d)
+	}
+	s.next = time.Now().Add(s.interval)
+}
+
+// Close restores the cursor hidden by the first frame
+func (s *Screen) Close() error {
+	if !s.started {
+		return nil
+	}
+	_, err := io.WriteString(s.w, reset+showCursor)
+	return err
+}
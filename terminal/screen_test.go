@@ -0,0 +1,63 @@
+package terminal
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stanciua/adventofcode2019/imaging"
+)
+
+var palette = imaging.Palette{
+	Background: color.Black,
+	Colors:     map[rune]color.Color{'#': color.RGBA{0xff, 0x80, 0x00, 0xff}},
+}
+
+func TestDraw(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewScreen(&buf, palette, 0)
+	if err := s.Draw([]string{"##."}, "score: 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := hideCursor + clearScreen + home +
+		"\x1b[48;2;255;128;0m    \x1b[48;2;0;0;0m  " + reset + clearLine + "\n" +
+		"score: 1" + clearLine + "\n" + clearBelow
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+
+	// the next frames are drawn over the first one
+	buf.Reset()
+	if err := s.Draw([]string{"."}, ""); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), home) {
+		t.Errorf("expected the frame to start at the top, got %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != reset+showCursor {
+		t.Errorf("expected the cursor to be restored, got %q", buf.String())
+	}
+}
+
+func TestFrameRate(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewScreen(&buf, palette, 50)
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := s.Draw([]string{"#"}, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the first frame is drawn right away, the others 20ms apart
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("expected at least 60ms for 4 frames at 50 fps, got %v", elapsed)
+	}
+}